@@ -3,20 +3,25 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"im-server/internal/connect"
 	"im-server/pkg/config"
 	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/messagepb"
 	"im-server/pkg/rpc"
 	"log/slog"
 	"net"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 
 	"im-server/pkg/broker"
 
 	"github.com/segmentio/kafka-go"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func main() {
@@ -24,15 +29,36 @@ func main() {
 	go func() {
 		connect.StartWSServer(config.Config.Services.Connect.WSAddr)
 	}()
+	// 启动 TCP 服务（未配置 tcp_addr 时不启用）
+	if addr := config.Config.Services.Connect.TCPAddr; addr != "" {
+		go connect.StartTCPServer(addr)
+	}
+	// 启动内部管理服务暴露指标（未配置 admin_addr 时不启用）
+	if addr := config.Config.Services.Connect.AdminAddr; addr != "" {
+		go connect.StartAdminServer(addr)
+	}
 
 	// 启动 Kafka 消费者：消费 `${prefix}.message.deliver`
-	go startKafkaConsumer()
+	consumerCtx, stopConsumers := context.WithCancel(context.Background())
+	var consumers sync.WaitGroup
+	consumers.Add(2)
+	go func() {
+		defer consumers.Done()
+		startKafkaConsumer(consumerCtx)
+	}()
+	// 房间广播需要每个节点都收到全量事件，单独以节点维度的消费组消费
+	go func() {
+		defer consumers.Done()
+		startRoomConsumer(consumerCtx)
+	}()
 
 	// gRPC 服务
 	server := grpc.NewServer(
 		grpc.UnaryInterceptor(rpc.ValidationUnaryInterceptor()),
 	)
-	// pb.RegisterConnectServiceServer(server, &connect.ConnectService{})
+	producer := broker.NewKafkaProducer(config.Config.Broker)
+	defer producer.Close()
+	connectpb.RegisterConnectIntServiceServer(server, connect.NewConnectIntService(producer))
 	listener, err := net.Listen("tcp", config.Config.Services.Connect.RPCAddr)
 	if err != nil {
 		panic(err)
@@ -49,46 +75,224 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	slog.Info("connect shutting down...")
-	server.GracefulStop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), connect.DrainTimeout())
+	defer cancel()
+
+	// 先停止 Kafka 消费并等待处理中的事件完成，排空期间不再向即将关闭的连接投递，
+	// 未消费的事件由消费组中的其他节点接管
+	stopConsumers()
+	consumersStopped := make(chan struct{})
+	go func() {
+		consumers.Wait()
+		close(consumersStopped)
+	}()
+	select {
+	case <-consumersStopped:
+	case <-ctx.Done():
+		slog.Warn("kafka consumers did not stop before drain deadline")
+	}
+
+	// 排空长连接：停止接入、通知客户端打散重连、批量离线并写完待发送数据
+	connect.Drain(ctx)
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+	}
 }
 
-func startKafkaConsumer() {
-	prefix := config.Config.Broker.TopicPrefix
-	topic := "message.deliver"
-	if prefix != "" {
-		topic = prefix + "." + topic
+// convTypeGroup 群聊会话类型，与 message 服务写入 outbox 的 conversation_type 一致
+const convTypeGroup = 2
+
+// topicName 为 topic 加上配置的前缀
+func topicName(name string) string {
+	if prefix := config.Config.Broker.TopicPrefix; prefix != "" {
+		return prefix + "." + name
 	}
-	consumer := broker.NewKafkaConsumer(config.Config.Broker, "connect-deliver", topic)
+	return name
+}
+
+func startKafkaConsumer(ctx context.Context) {
+	deliverTopic := topicName("message.deliver")
+	readTopic := topicName("message.read")
+	recallTopic := topicName("message.recall")
+	consumer := broker.NewKafkaConsumer(config.Config.Broker, "connect-deliver", deliverTopic, readTopic, recallTopic)
 	defer consumer.Close()
 
-	slog.Info("connect kafka consumer starting", "topic", topic)
-	ctx := context.Background()
-	type deliverPayload struct {
-		MessageID      string `json:"message_id"`
-		ConversationID string `json:"conversation_id"`
-		Seq            int64  `json:"seq"`
-		SenderID       uint64 `json:"sender_id"`
-		RecipientID    uint64 `json:"recipient_id"`
-		Type           int32  `json:"type"`
+	slog.Info("connect kafka consumer starting", "topics", []string{deliverTopic, readTopic, recallTopic})
+	if err := consumer.Start(ctx, func(ctx context.Context, m kafka.Message) error {
+		switch m.Topic {
+		case readTopic:
+			return handleReadEvent(m)
+		case recallTopic:
+			return handleRecallEvent(m)
+		default:
+			return handleDeliverEvent(m)
+		}
+	}); err != nil && !errors.Is(err, context.Canceled) {
+		slog.Error("kafka consumer stopped", "err", err)
 	}
+}
+
+// startRoomConsumer 消费 `${prefix}.room.broadcast`，消费组按节点区分，保证每个节点都能收到全部房间事件
+func startRoomConsumer(ctx context.Context) {
+	roomTopic := topicName(connect.RoomTopic)
+	groupID := "connect-room-" + config.Config.Services.Connect.LocalAddr
+	consumer := broker.NewKafkaConsumer(config.Config.Broker, groupID, roomTopic)
+	defer consumer.Close()
 
+	slog.Info("connect room consumer starting", "topic", roomTopic, "group", groupID)
 	if err := consumer.Start(ctx, func(ctx context.Context, m kafka.Message) error {
-		var p deliverPayload
-		if err := json.Unmarshal(m.Value, &p); err != nil {
-			slog.Error("invalid payload", "err", err)
+		return handleRoomEvent(m)
+	}); err != nil && !errors.Is(err, context.Canceled) {
+		slog.Error("room consumer stopped", "err", err)
+	}
+}
+
+// handleRoomEvent 处理 room.broadcast 事件，推送给本节点上订阅了该房间的全部连接
+func handleRoomEvent(m kafka.Message) error {
+	var p connect.RoomEvent
+	if err := json.Unmarshal(m.Value, &p); err != nil {
+		slog.Error("invalid room payload", "err", err)
+		return nil
+	}
+	n := connect.RoomBroadcast(p.RoomID, &connectpb.Packet{
+		Command: p.Command,
+		Data:    p.Data,
+	})
+	slog.Info("broadcast room packet", "roomID", p.RoomID, "command", p.Command, "conns", n)
+	return nil
+}
+
+// handleDeliverEvent 处理 message.deliver 事件，推送给接收方（群聊为全体成员）的在线设备
+func handleDeliverEvent(m kafka.Message) error {
+	type deliverPayload struct {
+		MessageID      string          `json:"message_id"`
+		ConversationID string          `json:"conversation_id"`
+		ConvType       int32           `json:"conversation_type"`
+		Seq            int64           `json:"seq"`
+		SenderID       uint64          `json:"sender_id"`
+		RecipientID    uint64          `json:"recipient_id"`
+		Recipients     []uint64        `json:"recipients"`
+		Type           int32           `json:"type"`
+		Event          json.RawMessage `json:"event"`
+	}
+
+	var p deliverPayload
+	if err := json.Unmarshal(m.Value, &p); err != nil {
+		slog.Error("invalid payload", "err", err)
+		return nil
+	}
+	event := &connectpb.MessageEvent{}
+	if len(p.Event) > 0 {
+		if err := protojson.Unmarshal(p.Event, event); err != nil {
+			slog.Error("invalid message event", "err", err)
 			return nil
 		}
-		pkt := &connectpb.Packet{
-			Command:   connectpb.Command_MESSAGE,
-			RequestId: 0,
-			Code:      0,
-			Message:   "",
-			Data:      m.Value, // 直接转发 JSON，客户端按约定解析
+	} else {
+		// 兼容旧事件：没有 event 时仅下发索引信息，客户端可通过历史消息接口补全内容
+		event = &connectpb.MessageEvent{
+			MessageId:      p.MessageID,
+			ConversationId: p.ConversationID,
+			Seq:            p.Seq,
+			Sender:         &messagepb.PeerInfo{UserId: p.SenderID},
+			RecipientId:    p.RecipientID,
+			MessageType:    p.Type,
 		}
-		n := connect.DeliverToUser(p.RecipientID, pkt)
-		slog.Info("delivered message", "recipient", p.RecipientID, "devices", n, "seq", p.Seq)
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		slog.Error("marshal message event", "err", err)
 		return nil
-	}); err != nil {
-		slog.Error("kafka consumer stopped", "err", err)
 	}
+	pkt := &connectpb.Packet{
+		Command: connectpb.Command_MESSAGE,
+		Data:    data,
+	}
+	// 兼容旧事件：单聊没有 recipients 时只投递给 recipient_id。
+	// 群聊的 recipient_id 是群组ID，不能作为用户投递；群内没有其他成员时无需投递
+	recipients := p.Recipients
+	if len(recipients) == 0 {
+		if p.ConvType == convTypeGroup || event.ConversationType == convTypeGroup {
+			slog.Info("group message without recipients, skip", "conversation", p.ConversationID, "seq", p.Seq)
+			return nil
+		}
+		recipients = []uint64{p.RecipientID}
+	}
+	n := connect.RouteToUsers(context.Background(), recipients, pkt)
+	slog.Info("delivered message", "recipients", len(recipients), "devices", n, "conversation", p.ConversationID, "seq", p.Seq)
+	return nil
+}
+
+// handleReadEvent 处理 message.read 事件，向会话参与者的在线设备推送已读回执
+func handleReadEvent(m kafka.Message) error {
+	type readPayload struct {
+		ConversationID string   `json:"conversation_id"`
+		ReaderID       uint64   `json:"reader_id"`
+		ReadSeq        int64    `json:"read_seq"`
+		Participants   []uint64 `json:"participants"`
+	}
+
+	var p readPayload
+	if err := json.Unmarshal(m.Value, &p); err != nil {
+		slog.Error("invalid read payload", "err", err)
+		return nil
+	}
+	data, err := proto.Marshal(&connectpb.ReadReceipt{
+		ConversationId: p.ConversationID,
+		ReaderId:       p.ReaderID,
+		ReadSeq:        p.ReadSeq,
+	})
+	if err != nil {
+		slog.Error("marshal read receipt", "err", err)
+		return nil
+	}
+	pkt := &connectpb.Packet{
+		Command: connectpb.Command_READ_RECEIPT,
+		Data:    data,
+	}
+	n := connect.RouteToUsers(context.Background(), p.Participants, pkt)
+	slog.Info("delivered read receipt", "users", len(p.Participants), "devices", n, "conversation", p.ConversationID, "readSeq", p.ReadSeq)
+	return nil
+}
+
+// handleRecallEvent 处理 message.recall 事件，向会话参与者的在线设备推送撤回通知
+func handleRecallEvent(m kafka.Message) error {
+	type recallPayload struct {
+		MessageID      string   `json:"message_id"`
+		ConversationID string   `json:"conversation_id"`
+		Seq            int64    `json:"seq"`
+		OperatorID     uint64   `json:"operator_id"`
+		Participants   []uint64 `json:"participants"`
+	}
+
+	var p recallPayload
+	if err := json.Unmarshal(m.Value, &p); err != nil {
+		slog.Error("invalid recall payload", "err", err)
+		return nil
+	}
+	data, err := proto.Marshal(&connectpb.RecallNotice{
+		ConversationId: p.ConversationID,
+		MessageId:      p.MessageID,
+		Seq:            p.Seq,
+		OperatorId:     p.OperatorID,
+	})
+	if err != nil {
+		slog.Error("marshal recall notice", "err", err)
+		return nil
+	}
+	pkt := &connectpb.Packet{
+		Command: connectpb.Command_RECALL,
+		Data:    data,
+	}
+	n := connect.RouteToUsers(context.Background(), p.Participants, pkt)
+	slog.Info("delivered recall notice", "users", len(p.Participants), "devices", n, "messageID", p.MessageID)
+	return nil
 }
@@ -2,12 +2,16 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"im-server/internal/device"
 	"im-server/pkg/config"
+	"im-server/pkg/dao"
 	devicepb "im-server/pkg/protocol/pb/devicepb"
+	"log"
 	"log/slog"
 	"net"
 
+	_ "github.com/go-sql-driver/mysql"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -24,6 +28,12 @@ func validationUnaryInterceptor(ctx context.Context, req interface{}, info *grpc
 }
 
 func main() {
+	// 初始化数据库连接
+	db, err := sql.Open("mysql", config.Config.Database.MySQL.DSN)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
 
 	server := grpc.NewServer(
 		grpc.UnaryInterceptor(validationUnaryInterceptor),
@@ -33,7 +43,7 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	devicepb.RegisterDeviceIntServiceServer(server, &device.DeviceIntService{})
+	devicepb.RegisterDeviceIntServiceServer(server, device.NewDeviceIntService(dao.New(db)))
 	err = server.Serve(listener)
 	if err != nil {
 		slog.Error("serve error", "error", err)
@@ -38,7 +38,24 @@ func main() {
 	log.Printf("  POST /api/v1/auth/login - User login")
 	log.Printf("  POST /api/v1/auth/verify - Token verification")
 	log.Printf("  POST /api/v1/user/search - User search")
-	log.Printf("  POST /api/v1/message - Send message")
+	log.Printf("  POST /api/v1/message - Send message (P2P/group)")
+	log.Printf("  GET  /api/v1/conversation/{conversation_id}/messages - Conversation history")
+	log.Printf("  GET  /api/v1/conversation/list - Conversation list")
+	log.Printf("  POST /api/v1/conversation/{conversation_id}/read - Mark conversation read")
+	log.Printf("  POST /api/v1/message/{message_id}/recall - Recall message")
+	log.Printf("  POST /api/v1/message/sync - Sync missed messages")
+	log.Printf("  POST /api/v1/group - Create group")
+	log.Printf("  POST /api/v1/group/{group_id}/members - Invite group members")
+	log.Printf("  POST /api/v1/group/{group_id}/leave - Leave group")
+	log.Printf("  DELETE /api/v1/group/{group_id} - Dissolve group")
+	log.Printf("  DELETE /api/v1/group/{group_id}/members/{user_id} - Kick group member")
+	log.Printf("  PUT  /api/v1/group/{group_id}/admins/{user_id} - Set group admin")
+	log.Printf("  POST /api/v1/group/{group_id}/mute - Mute all members")
+	log.Printf("  DELETE /api/v1/group/{group_id}/mute - Unmute all members")
+	log.Printf("  POST /api/v1/group/{group_id}/members/{user_id}/mute - Mute group member")
+	log.Printf("  DELETE /api/v1/group/{group_id}/members/{user_id}/mute - Unmute group member")
+	log.Printf("  GET  /api/v1/group/{group_id}/members - Group members")
+	log.Printf("  GET  /api/v1/group/list - My groups")
 
 	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", port), gatewayServer))
 }
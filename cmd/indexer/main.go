@@ -17,14 +17,26 @@ import (
 
 // deliverPayload 与消息投递事件的 payload 对齐
 type deliverPayload struct {
-	MessageID      string `json:"message_id"`
-	ConversationID string `json:"conversation_id"`
-	Seq            int64  `json:"seq"`
-	SenderID       uint64 `json:"sender_id"`
-	RecipientID    uint64 `json:"recipient_id"`
-	Type           int32  `json:"type"`
+	MessageID        string    `json:"message_id"`
+	ConversationID   string    `json:"conversation_id"`
+	ConversationType int8      `json:"conversation_type"`
+	Seq              int64     `json:"seq"`
+	SenderID         uint64    `json:"sender_id"`
+	RecipientID      uint64    `json:"recipient_id"`
+	GroupID          uint64    `json:"group_id"`
+	Mentions         []uint64  `json:"mentions"`
+	ReplyTo          *quoteRef `json:"reply_to"`
+	Type             int32     `json:"type"`
 }
 
+// quoteRef 负载中被引用消息的摘要，仅需 message_id
+type quoteRef struct {
+	MessageID string `json:"message_id"`
+}
+
+// 群聊会话类型，与 conversation.type 一致
+const convTypeGroup = 2
+
 func main() {
 	ctx := context.Background()
 
@@ -71,6 +83,7 @@ func main() {
 			RecipientID:    p.RecipientID,
 			MessageType:    int8(p.Type),
 			Seq:            p.Seq,
+			ReplyToMsgID:   replyToMsgID(p.ReplyTo),
 			// Status 保持默认或按需设置
 		}); err != nil {
 			if isDuplicate(err) {
@@ -82,38 +95,74 @@ func main() {
 			}
 		}
 
-		// 2) Upsert 会话元信息（last_message_id/last_seq）
-		if err := qq.UpsertConversationOnSend(ctx, dao.UpsertConversationOnSendParams{
-			ConversationID: p.ConversationID,
-			JSONARRAY:      p.SenderID,
-			JSONARRAY_2:    p.RecipientID,
-			LastMessageID:  sql.NullString{String: p.MessageID, Valid: true},
-			LastSeq:        sql.NullInt64{Int64: p.Seq, Valid: true},
-		}); err != nil {
-			log.Printf("upsert conversation err: %v", err)
-			rollback()
-			return nil
-		}
+		if p.ConversationType == convTypeGroup {
+			// 2) Upsert 群聊会话元信息
+			if err := qq.UpsertGroupConversationOnSend(ctx, dao.UpsertGroupConversationOnSendParams{
+				ConversationID: p.ConversationID,
+				LastMessageID:  sql.NullString{String: p.MessageID, Valid: true},
+				LastSeq:        sql.NullInt64{Int64: p.Seq, Valid: true},
+			}); err != nil {
+				log.Printf("upsert group conversation err: %v", err)
+				rollback()
+				return nil
+			}
 
-		// 3) 确保双方 user_conversation 存在
-		if err := qq.UpsertUserConversationOnSend(ctx, dao.UpsertUserConversationOnSendParams{UserID: p.SenderID, ConversationID: p.ConversationID}); err != nil {
-			log.Printf("upsert sender conv err: %v", err)
-			rollback()
-			return nil
-		}
-		if err := qq.UpsertUserConversationOnSend(ctx, dao.UpsertUserConversationOnSendParams{UserID: p.RecipientID, ConversationID: p.ConversationID}); err != nil {
-			log.Printf("upsert recipient conv err: %v", err)
-			rollback()
-			return nil
-		}
+			// 3) 确保全体成员 user_conversation 存在
+			if err := qq.UpsertGroupUserConversationsOnSend(ctx, dao.UpsertGroupUserConversationsOnSendParams{ConversationID: p.ConversationID, GroupID: p.GroupID}); err != nil {
+				log.Printf("upsert member convs err: %v", err)
+				rollback()
+				return nil
+			}
+
+			// 4) 未读 +1：仅当本次确认为新插入（非重复）
+			if !dup {
+				if err := qq.IncrGroupUnreadExceptSender(ctx, dao.IncrGroupUnreadExceptSenderParams{ConversationID: p.ConversationID, SenderID: p.SenderID, GroupID: p.GroupID}); err != nil {
+					log.Printf("incr group unread err: %v", err)
+					rollback()
+					return nil
+				}
+				if len(p.Mentions) > 0 {
+					if err := qq.IncrMentionOnUsers(ctx, dao.IncrMentionOnUsersParams{Seq: p.Seq, ConversationID: p.ConversationID, UserIds: p.Mentions}); err != nil {
+						log.Printf("incr mention err: %v", err)
+						rollback()
+						return nil
+					}
+				}
+			}
+		} else {
+			// 2) Upsert 会话元信息（last_message_id/last_seq）
+			if err := qq.UpsertConversationOnSend(ctx, dao.UpsertConversationOnSendParams{
+				ConversationID: p.ConversationID,
+				JSONARRAY:      p.SenderID,
+				JSONARRAY_2:    p.RecipientID,
+				LastMessageID:  sql.NullString{String: p.MessageID, Valid: true},
+				LastSeq:        sql.NullInt64{Int64: p.Seq, Valid: true},
+			}); err != nil {
+				log.Printf("upsert conversation err: %v", err)
+				rollback()
+				return nil
+			}
 
-		// 4) 未读 +1：仅当本次确认为新插入（非重复）
-		if !dup {
-			if err := qq.IncrUnreadOnRecipient(ctx, dao.IncrUnreadOnRecipientParams{UserID: p.RecipientID, ConversationID: p.ConversationID}); err != nil {
-				log.Printf("incr unread err: %v", err)
+			// 3) 确保双方 user_conversation 存在
+			if err := qq.UpsertUserConversationOnSend(ctx, dao.UpsertUserConversationOnSendParams{UserID: p.SenderID, ConversationID: p.ConversationID}); err != nil {
+				log.Printf("upsert sender conv err: %v", err)
 				rollback()
 				return nil
 			}
+			if err := qq.UpsertUserConversationOnSend(ctx, dao.UpsertUserConversationOnSendParams{UserID: p.RecipientID, ConversationID: p.ConversationID}); err != nil {
+				log.Printf("upsert recipient conv err: %v", err)
+				rollback()
+				return nil
+			}
+
+			// 4) 未读 +1：仅当本次确认为新插入（非重复）
+			if !dup {
+				if err := qq.IncrUnreadOnRecipient(ctx, dao.IncrUnreadOnRecipientParams{UserID: p.RecipientID, ConversationID: p.ConversationID}); err != nil {
+					log.Printf("incr unread err: %v", err)
+					rollback()
+					return nil
+				}
+			}
 		}
 
 		if err := sqlTx.Commit(); err != nil {
@@ -128,6 +177,14 @@ func main() {
 	}
 }
 
+// replyToMsgID 提取负载中引用消息的ID，未引用时为 NULL
+func replyToMsgID(ref *quoteRef) sql.NullString {
+	if ref == nil || ref.MessageID == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: ref.MessageID, Valid: true}
+}
+
 // isDuplicate 判断是否为主键/唯一键冲突
 func isDuplicate(err error) bool {
 	var me mysqlError
@@ -139,4 +196,3 @@ func isDuplicate(err error) bool {
 
 // 适配 go-sql-driver/mysql 错误类型（避免直接依赖具体包名）
 type mysqlError interface{ Number() uint16 }
- 
\ No newline at end of file
@@ -48,9 +48,25 @@ func main() {
 		),
 	)
 
-	msgSvc := message.NewMessageExtService(queries, rdb, mongoCli)
+	msgSvc := message.NewMessageExtService(db, queries, rdb, mongoCli)
 	messagepb.RegisterMessageExtServiceServer(server, msgSvc)
 
+	// 内部服务不做用户认证，单独监听在仅内网可达的地址上，不与外部服务共用端口
+	intServer := grpc.NewServer(
+		grpc.UnaryInterceptor(rpc.ValidationUnaryInterceptor()),
+	)
+	messagepb.RegisterMessageIntServiceServer(intServer, message.NewMessageIntService(msgSvc))
+	intListener, err := net.Listen("tcp", config.Config.Services.Message.IntRPCAddr)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	log.Printf("Message internal service is running on %s", config.Config.Services.Message.IntRPCAddr)
+	go func() {
+		if err := intServer.Serve(intListener); err != nil {
+			log.Fatalf("failed to serve internal: %v", err)
+		}
+	}()
+
 	listener, err := net.Listen("tcp", config.Config.Services.Message.RPCAddr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -0,0 +1,4 @@
+-- Revert group mute columns
+
+ALTER TABLE `group_user` DROP COLUMN `muted_until`;
+ALTER TABLE `group` DROP COLUMN `mute_all_until`;
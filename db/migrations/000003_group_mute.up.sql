@@ -0,0 +1,7 @@
+-- 群禁言：全员禁言与单个成员禁言，均带截止时间（NULL 表示未禁言）
+
+ALTER TABLE `group`
+  ADD COLUMN `mute_all_until` datetime DEFAULT NULL COMMENT '全员禁言截止时间';
+
+ALTER TABLE `group_user`
+  ADD COLUMN `muted_until` datetime DEFAULT NULL COMMENT '成员禁言截止时间';
@@ -0,0 +1,5 @@
+-- Revert user conversation mention columns
+
+ALTER TABLE `user_conversation`
+  DROP COLUMN `first_unread_mention_seq`,
+  DROP COLUMN `mention_count`;
@@ -0,0 +1,5 @@
+-- 用户会话 @ 提醒：未读 @ 数与首条未读 @ 消息的 seq
+
+ALTER TABLE `user_conversation`
+  ADD COLUMN `mention_count` INT NOT NULL DEFAULT 0 COMMENT '未读@数',
+  ADD COLUMN `first_unread_mention_seq` BIGINT NOT NULL DEFAULT 0 COMMENT '首条未读@消息序列号，0 表示无';
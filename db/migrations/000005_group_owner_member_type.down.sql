@@ -0,0 +1,6 @@
+-- Revert group owner member type
+
+UPDATE `group_user` SET `member_type` = 0 WHERE `member_type` = 3;
+
+ALTER TABLE `group_user`
+  MODIFY COLUMN `member_type` tinyint NOT NULL COMMENT '成员类型，1：管理员；2：普通成员' AFTER `updated_at`;
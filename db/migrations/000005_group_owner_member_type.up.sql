@@ -0,0 +1,6 @@
+-- 群主的成员类型由 0 改为 3，0 保留为未指定
+
+UPDATE `group_user` SET `member_type` = 3 WHERE `member_type` = 0;
+
+ALTER TABLE `group_user`
+  MODIFY COLUMN `member_type` tinyint NOT NULL COMMENT '成员类型，1：管理员；2：普通成员；3：群主' AFTER `updated_at`;
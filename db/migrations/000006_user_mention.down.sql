@@ -0,0 +1,3 @@
+-- Revert user mention table
+
+DROP TABLE IF EXISTS `user_mention`;
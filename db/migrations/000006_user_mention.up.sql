@@ -0,0 +1,9 @@
+-- 用户未读 @ 明细：按 seq 记录每条 @，标记已读时只清除已读位置及之前的 @
+
+CREATE TABLE IF NOT EXISTS `user_mention` (
+  `user_id` BIGINT UNSIGNED NOT NULL COMMENT '被@的用户ID',
+  `conversation_id` VARCHAR(32) NOT NULL COMMENT '会话ID',
+  `seq` BIGINT NOT NULL COMMENT '@消息序列号',
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`user_id`, `conversation_id`, `seq`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户未读@';
@@ -0,0 +1,46 @@
+-- name: ListPinnedUserConversations :many
+-- 获取用户置顶的会话（按最后活跃时间倒序）
+SELECT uc.conversation_id, uc.last_read_seq, uc.unread_count, uc.is_muted, uc.is_pinned,
+       uc.mention_count, uc.first_unread_mention_seq,
+       c.type, c.participants, c.last_message_id, c.last_seq, c.updated_at
+FROM user_conversation uc
+JOIN conversation c ON c.conversation_id = uc.conversation_id
+WHERE uc.user_id = ? AND uc.is_pinned = 1
+ORDER BY c.updated_at DESC, c.conversation_id DESC
+LIMIT ?;
+
+-- name: ListUserConversations :many
+-- 获取用户未置顶的会话，按 (updated_at, conversation_id) 键集分页
+SELECT uc.conversation_id, uc.last_read_seq, uc.unread_count, uc.is_muted, uc.is_pinned,
+       uc.mention_count, uc.first_unread_mention_seq,
+       c.type, c.participants, c.last_message_id, c.last_seq, c.updated_at
+FROM user_conversation uc
+JOIN conversation c ON c.conversation_id = uc.conversation_id
+WHERE uc.user_id = sqlc.arg(user_id) AND uc.is_pinned = 0
+  AND (c.updated_at < sqlc.arg(cursor_updated_at)
+       OR (c.updated_at = sqlc.arg(cursor_updated_at) AND c.conversation_id < sqlc.arg(cursor_conversation_id)))
+ORDER BY c.updated_at DESC, c.conversation_id DESC
+LIMIT ?;
+
+-- name: ListUserConversationsSince :many
+-- 全局收件箱同步：获取用户自 since 起有更新的会话（最近更新的优先），以及各会话在 since 之前的最大 seq
+SELECT c.conversation_id,
+       CAST(COALESCE((SELECT mi.seq FROM message_index mi
+                      WHERE mi.conversation_id = c.conversation_id AND mi.created_at < sqlc.arg(since)
+                      ORDER BY mi.seq DESC
+                      LIMIT 1), 0) AS SIGNED) AS start_seq
+FROM user_conversation uc
+JOIN conversation c ON c.conversation_id = uc.conversation_id
+WHERE uc.user_id = sqlc.arg(user_id) AND c.updated_at >= sqlc.arg(since)
+ORDER BY c.updated_at DESC, c.conversation_id DESC
+LIMIT ?;
+
+-- name: DeleteUserConversation :exec
+-- 删除用户的会话视图（退群/被移出群时清理）
+DELETE FROM user_conversation
+WHERE user_id = ? AND conversation_id = ?;
+
+-- name: DeleteConversationUsers :exec
+-- 删除会话下全部用户视图（解散群组时清理）
+DELETE FROM user_conversation
+WHERE conversation_id = ?;
@@ -52,7 +52,7 @@ LIMIT 1;
 -- 获取群组所有成员
 SELECT * FROM `group_user` 
 WHERE group_id = ?
-ORDER BY member_type ASC, created_at ASC;
+ORDER BY FIELD(member_type, 3, 1, 2), created_at ASC;
 
 -- name: GetUserGroups :many
 -- 获取用户参与的所有群组
@@ -70,3 +70,32 @@ WHERE group_id = ? AND user_id = ?;
 -- 移除群组成员
 DELETE FROM `group_user` 
 WHERE group_id = ? AND user_id = ?;
+
+-- name: ListGroupsByIDs :many
+-- 根据群组ID批量获取群组信息
+SELECT * FROM `group`
+WHERE id IN (sqlc.slice(ids));
+
+-- name: SyncGroupUserNum :exec
+-- 按 group_user 实际成员数回写群组人数
+UPDATE `group`
+SET updated_at = sqlc.arg(updated_at),
+    user_num = (SELECT COUNT(*) FROM `group_user` WHERE `group_user`.group_id = sqlc.arg(id))
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteGroupUsers :exec
+-- 移除群组全部成员（解散群组）
+DELETE FROM `group_user`
+WHERE group_id = ?;
+
+-- name: SetGroupMuteAll :exec
+-- 设置/清除全员禁言（mute_all_until 为 NULL 表示解除）
+UPDATE `group`
+SET updated_at = ?, mute_all_until = ?
+WHERE id = ?;
+
+-- name: SetGroupUserMute :exec
+-- 设置/清除成员禁言（muted_until 为 NULL 表示解除）
+UPDATE `group_user`
+SET updated_at = ?, muted_until = ?
+WHERE group_id = ? AND user_id = ?;
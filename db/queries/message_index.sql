@@ -6,10 +6,24 @@ INSERT INTO message_index (
 );
 
 -- name: GetConversationMessages :many
+-- 按 seq 游标向前翻页：取 (after_seq, before_seq) 区间内最新的若干条，seq 倒序
 SELECT * FROM message_index
-WHERE conversation_id = ?
+WHERE conversation_id = sqlc.arg(conversation_id)
+  AND seq > sqlc.arg(after_seq)
+  AND seq < sqlc.arg(before_seq)
 ORDER BY seq DESC
-LIMIT ? OFFSET ?;
+LIMIT ?;
+
+-- name: GetConversationMessagesAfter :many
+-- 按 seq 游标向后追赶：取 after_seq 之后最早的若干条，seq 正序
+SELECT * FROM message_index
+WHERE conversation_id = ? AND seq > ?
+ORDER BY seq ASC
+LIMIT ?;
+
+-- name: GetConversation :one
+SELECT * FROM conversation
+WHERE conversation_id = ? LIMIT 1;
 
 -- name: GetUnreadByUserAndConversation :one
 SELECT unread_count FROM user_conversation
@@ -26,11 +40,91 @@ SET unread_count = unread_count + 1, updated_at = CURRENT_TIMESTAMP
 WHERE user_id = ? AND conversation_id = ?;
 
 -- name: MarkRead :exec
+-- 推进已读位置并清零未读；未读 @ 按剩余的 @ 明细重新统计，需先执行 DeleteReadMentions
 UPDATE user_conversation
-SET last_read_seq = GREATEST(last_read_seq, ?), unread_count = 0, updated_at = CURRENT_TIMESTAMP
+SET last_read_seq = GREATEST(last_read_seq, CAST(sqlc.arg(read_seq) AS SIGNED)), unread_count = 0,
+    mention_count = (
+        SELECT COUNT(*) FROM user_mention m
+        WHERE m.user_id = user_conversation.user_id
+          AND m.conversation_id = user_conversation.conversation_id
+    ),
+    first_unread_mention_seq = COALESCE((
+        SELECT MIN(m.seq) FROM user_mention m
+        WHERE m.user_id = user_conversation.user_id
+          AND m.conversation_id = user_conversation.conversation_id
+    ), 0),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_conversation.user_id = sqlc.arg(user_id) AND user_conversation.conversation_id = sqlc.arg(conversation_id);
+
+-- name: GetLastReadSeq :one
+SELECT last_read_seq FROM user_conversation
 WHERE user_id = ? AND conversation_id = ?;
 
+-- name: DeleteReadMentions :exec
+-- 删除已读位置及之前的 @ 明细
+DELETE FROM user_mention
+WHERE user_id = ? AND conversation_id = ? AND seq <= ?;
+
+-- name: ListMessageSenders :many
+-- 获取会话 seq 区间 (after_seq, to_seq] 内消息的发送者（去重），用于群聊已读回执
+SELECT DISTINCT sender_id FROM message_index
+WHERE conversation_id = sqlc.arg(conversation_id)
+  AND seq > sqlc.arg(after_seq)
+  AND seq <= sqlc.arg(to_seq);
+
 -- name: UpsertConversationOnSend :exec
 INSERT INTO conversation (conversation_id, type, participants, last_message_id, last_seq)
 VALUES (?, 1, JSON_ARRAY(?, ?), ?, ?)
 ON DUPLICATE KEY UPDATE last_message_id = VALUES(last_message_id), last_seq = VALUES(last_seq), updated_at = CURRENT_TIMESTAMP;
+
+-- name: GetMessageIndex :one
+SELECT * FROM message_index
+WHERE message_id = ? LIMIT 1;
+
+-- name: UpdateMessageIndexStatus :exec
+UPDATE message_index
+SET status = ?, updated_at = CURRENT_TIMESTAMP
+WHERE message_id = ?;
+
+-- name: UpsertGroupConversationOnSend :exec
+-- 群聊会话：参与者以 group_user 为准，participants 留空
+INSERT INTO conversation (conversation_id, type, participants, last_message_id, last_seq)
+VALUES (?, 2, JSON_ARRAY(), ?, ?)
+ON DUPLICATE KEY UPDATE last_message_id = VALUES(last_message_id), last_seq = VALUES(last_seq), updated_at = CURRENT_TIMESTAMP;
+
+-- name: UpsertGroupUserConversationsOnSend :exec
+-- 为群内所有成员确保 user_conversation 存在
+INSERT INTO user_conversation (user_id, conversation_id, last_read_seq, unread_count, is_muted, is_pinned)
+SELECT gu.user_id, sqlc.arg(conversation_id), 0, 0, 0, 0
+FROM group_user gu
+WHERE gu.group_id = sqlc.arg(group_id)
+ON DUPLICATE KEY UPDATE user_conversation.updated_at = CURRENT_TIMESTAMP;
+
+-- name: IncrGroupUnreadExceptSender :exec
+-- 群内除发送者外的成员未读 +1
+UPDATE user_conversation uc
+SET uc.unread_count = uc.unread_count + 1, uc.updated_at = CURRENT_TIMESTAMP
+WHERE uc.conversation_id = sqlc.arg(conversation_id)
+  AND uc.user_id <> sqlc.arg(sender_id)
+  AND uc.user_id IN (SELECT gu.user_id FROM group_user gu WHERE gu.group_id = sqlc.arg(group_id));
+
+-- name: IncrMentionOnUsers :exec
+-- 被 @ 的成员未读 @ 数 +1，并记录首条未读 @ 的 seq
+UPDATE user_conversation
+SET mention_count = mention_count + 1,
+    first_unread_mention_seq = IF(first_unread_mention_seq = 0, CAST(sqlc.arg(seq) AS SIGNED), first_unread_mention_seq),
+    updated_at = CURRENT_TIMESTAMP
+WHERE conversation_id = sqlc.arg(conversation_id)
+  AND user_id IN (sqlc.slice(user_ids));
+
+-- name: InsertUserMentions :exec
+-- 记录被 @ 成员的 @ 明细，仅记录仍在会话中的成员
+INSERT IGNORE INTO user_mention (user_id, conversation_id, seq)
+SELECT uc.user_id, uc.conversation_id, CAST(sqlc.arg(seq) AS SIGNED) FROM user_conversation uc
+WHERE uc.conversation_id = sqlc.arg(conversation_id)
+  AND uc.user_id IN (sqlc.slice(user_ids));
+
+-- name: ListMessageIndexByIDs :many
+-- 按 message_id 批量获取消息索引（用于组装引用消息）
+SELECT * FROM message_index
+WHERE message_id IN (sqlc.slice(message_ids));
@@ -85,4 +85,9 @@ WHERE email = ? LIMIT 1;
 
 -- name: UserExistsByUsername :one
 -- 检查用户名是否存在
-SELECT EXISTS(SELECT 1 FROM user WHERE username = ? LIMIT 1);
\ No newline at end of file
+SELECT EXISTS(SELECT 1 FROM user WHERE username = ? LIMIT 1);
+
+-- name: ListUsersByIDs :many
+-- 根据用户ID批量获取用户公开资料
+SELECT id, username, nickname, avatar_url FROM `user`
+WHERE id IN (sqlc.slice(ids));
@@ -15,6 +15,7 @@ import (
 	"im-server/pkg/config"
 	authpb "im-server/pkg/protocol/pb/authpb"
 	friendpb "im-server/pkg/protocol/pb/friendpb"
+	grouppb "im-server/pkg/protocol/pb/grouppb"
 	messagepb "im-server/pkg/protocol/pb/messagepb"
 	userpb "im-server/pkg/protocol/pb/userpb"
 )
@@ -113,11 +114,21 @@ func (g *GatewayServer) RegisterHandlers() error {
 		return fmt.Errorf("failed to register message service at %s: %v", messageAddr, err)
 	}
 
+	// 注册群组服务
+	groupAddr := g.config.Services.Group.RPCAddr
+	if groupAddr == "" {
+		groupAddr = "localhost:50057" // 默认地址
+	}
+	if err := grouppb.RegisterGroupExtServiceHandlerFromEndpoint(ctx, g.mux, groupAddr, opts); err != nil {
+		return fmt.Errorf("failed to register group service at %s: %v", groupAddr, err)
+	}
+
 	log.Printf("Successfully registered grpc-gateway handlers:")
 	log.Printf("  Auth service: %s", authAddr)
 	log.Printf("  User service: %s", userAddr)
 	log.Printf("  Friend service: %s", friendAddr)
 	log.Printf("  Message service: %s", messageAddr)
+	log.Printf("  Group service: %s", groupAddr)
 
 	return nil
 }
@@ -0,0 +1,24 @@
+package connect
+
+import (
+	"errors"
+	"expvar"
+	"log/slog"
+	"net/http"
+)
+
+// adminMux 内部管理路由，暴露 expvar 指标（写队列深度、内存统计等），不与面向客户端的 WS 服务共用
+func adminMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	return mux
+}
+
+// StartAdminServer 在内部管理地址上启动指标服务，应只绑定内网地址
+func StartAdminServer(addr string) {
+	server := &http.Server{Addr: addr, Handler: adminMux()}
+	slog.Info("admin server running", "addr", addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("start admin server", "err", err)
+	}
+}
@@ -0,0 +1,94 @@
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+
+	"im-server/pkg/broker"
+	"im-server/pkg/protocol/pb/connectpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ConnectIntService 是 connect 节点对内暴露的 gRPC 服务，供其他节点或业务服务直接向连接推送数据，
+// 调用方可连接任意节点，设备不在本节点时由本节点按在线信息转发
+type ConnectIntService struct {
+	connectpb.UnimplementedConnectIntServiceServer
+	producer *broker.KafkaProducer // 房间广播发布，为空时仅广播到本节点
+}
+
+func NewConnectIntService(producer *broker.KafkaProducer) *ConnectIntService {
+	return &ConnectIntService{producer: producer}
+}
+
+// DeliverToDevices 将 Packet 投递到本节点上的指定设备；close_after 时按 kick_reason 将设备踢下线
+func (s *ConnectIntService) DeliverToDevices(ctx context.Context, req *connectpb.DeliverToDevicesRequest) (*connectpb.DeliverToDevicesReply, error) {
+	if req.CloseAfter {
+		if req.Packet != nil {
+			return nil, status.Error(codes.InvalidArgument, "packet must be empty when close_after is set")
+		}
+		n := kickLocal(req.DeviceIds, req.KickReason)
+		return &connectpb.DeliverToDevicesReply{Delivered: uint32(n)}, nil
+	}
+	if req.Packet == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing packet")
+	}
+	n := deliverLocal(req.DeviceIds, req.Packet)
+	return &connectpb.DeliverToDevicesReply{Delivered: uint32(n)}, nil
+}
+
+// PushToDevice 向指定设备推送 Packet
+func (s *ConnectIntService) PushToDevice(ctx context.Context, req *connectpb.PushToDeviceRequest) (*connectpb.PushReply, error) {
+	if req.Packet == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing packet")
+	}
+	reply := &connectpb.PushReply{}
+	if RouteToDevice(ctx, req.UserId, req.DeviceId, req.Packet) {
+		reply.Delivered = 1
+	}
+	return reply, nil
+}
+
+// PushToUser 向用户的全部在线设备推送 Packet
+func (s *ConnectIntService) PushToUser(ctx context.Context, req *connectpb.PushToUserRequest) (*connectpb.PushReply, error) {
+	if req.Packet == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing packet")
+	}
+	n := RouteToUsers(ctx, req.UserIds, req.Packet)
+	return &connectpb.PushReply{Delivered: uint32(n)}, nil
+}
+
+// PushToRoom 向房间推送 Packet。房间订阅分散在各个节点，经房间广播主题下发到所有节点
+func (s *ConnectIntService) PushToRoom(ctx context.Context, req *connectpb.PushToRoomRequest) (*emptypb.Empty, error) {
+	if req.Packet == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing packet")
+	}
+	if s.producer == nil {
+		RoomBroadcast(req.RoomId, req.Packet)
+		return new(emptypb.Empty), nil
+	}
+	payload, err := json.Marshal(RoomEvent{
+		RoomID:  req.RoomId,
+		Command: req.Packet.Command,
+		Data:    req.Packet.Data,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshal room event: %v", err)
+	}
+	key := strconv.FormatUint(req.RoomId, 10)
+	if err := s.producer.Publish(ctx, s.producer.Topic(RoomTopic), []byte(key), payload); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "publish room event: %v", err)
+	}
+	return new(emptypb.Empty), nil
+}
+
+// KickDevice 强制设备下线：下发携带原因的 KICKED 通知后关闭连接
+func (s *ConnectIntService) KickDevice(ctx context.Context, req *connectpb.KickDeviceRequest) (*connectpb.KickDeviceReply, error) {
+	kicked := RouteKick(ctx, req.UserId, req.DeviceId, req.Reason)
+	slog.Info("kick device", "userID", req.UserId, "deviceID", req.DeviceId, "reason", req.Reason, "kicked", kicked)
+	return &connectpb.KickDeviceReply{Kicked: kicked}, nil
+}
@@ -0,0 +1,160 @@
+package connect
+
+import (
+	"context"
+	"testing"
+
+	"im-server/pkg/config"
+	"im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/devicepb"
+	"im-server/pkg/rpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestKickDeviceLocal(t *testing.T) {
+	conn, tr := newTestConn(t)
+	SetConnection(conn.Session.DeviceID, conn)
+
+	service := NewConnectIntService(nil)
+	reply, err := service.KickDevice(context.Background(), &connectpb.KickDeviceRequest{
+		UserId:   conn.Session.UserID,
+		DeviceId: conn.Session.DeviceID,
+		Reason:   "account disabled",
+	})
+	require.NoError(t, err)
+	assert.True(t, reply.Kicked)
+
+	writes, closed := tr.state()
+	assert.Equal(t, 1, writes)
+	assert.True(t, closed)
+	assert.Nil(t, GetConnection(conn.Session.DeviceID))
+
+	var pkt connectpb.Packet
+	require.NoError(t, proto.Unmarshal(tr.writes[0], &pkt))
+	assert.Equal(t, connectpb.Command_KICKED, pkt.Command)
+	var notice connectpb.KickedNotice
+	require.NoError(t, proto.Unmarshal(pkt.Data, &notice))
+	assert.Equal(t, "account disabled", notice.Reason)
+}
+
+func TestPushToDeviceForwardsToOwningNode(t *testing.T) {
+	config.Config.Services.Connect.LocalAddr = "node-a:50055"
+
+	ctrl := gomock.NewController(t)
+	mockDevice := mocks.NewMockDeviceIntServiceClient(ctrl)
+	mockDevice.EXPECT().ListOnlineDevices(gomock.Any(), &devicepb.ListOnlineDevicesRequest{UserIds: []uint64{2}}).Return(&devicepb.ListOnlineDevicesReply{
+		Devices: []*devicepb.OnlineDevice{
+			{DeviceId: 200, UserId: 2, ConnAddr: "node-b:50055"},
+			{DeviceId: 201, UserId: 2, ConnAddr: "node-c:50055"},
+		},
+	}, nil)
+	rpc.SetDeviceIntServiceClient(mockDevice)
+
+	remote := &fakeConnectInt{}
+	rpc.SetConnectIntServiceClient("node-c:50055", remote)
+
+	service := NewConnectIntService(nil)
+	reply, err := service.PushToDevice(context.Background(), &connectpb.PushToDeviceRequest{
+		UserId:   2,
+		DeviceId: 201,
+		Packet:   &connectpb.Packet{Command: connectpb.Command_READ_RECEIPT},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), reply.Delivered)
+	if assert.Len(t, remote.reqs, 1) {
+		assert.Equal(t, []uint64{201}, remote.reqs[0].DeviceIds)
+		assert.False(t, remote.reqs[0].CloseAfter)
+	}
+}
+
+func TestDeliverToDevicesCloseAfterKicks(t *testing.T) {
+	conn, tr := newTestConn(t)
+	SetConnection(conn.Session.DeviceID, conn)
+
+	// 其他节点转发的踢下线请求，本节点按通知中的原因踢下线
+	service := NewConnectIntService(nil)
+	reply, err := service.DeliverToDevices(context.Background(), &connectpb.DeliverToDevicesRequest{
+		DeviceIds:  []uint64{conn.Session.DeviceID, 999},
+		CloseAfter: true,
+		KickReason: "superseded",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), reply.Delivered)
+
+	_, closed := tr.state()
+	assert.True(t, closed)
+	assert.Nil(t, GetConnection(conn.Session.DeviceID))
+	pkt := lastPacket(t, tr)
+	assert.Equal(t, connectpb.Command_KICKED, pkt.Command)
+	var notice connectpb.KickedNotice
+	require.NoError(t, proto.Unmarshal(pkt.Data, &notice))
+	assert.Equal(t, "superseded", notice.Reason)
+}
+
+func TestDeliverToDevicesCloseAfterRejectsPacket(t *testing.T) {
+	conn, tr := newTestConn(t)
+	SetConnection(conn.Session.DeviceID, conn)
+	defer conn.Close()
+
+	// close_after 不能携带普通数据包，否则数据包会被静默丢弃
+	service := NewConnectIntService(nil)
+	_, err := service.DeliverToDevices(context.Background(), &connectpb.DeliverToDevicesRequest{
+		DeviceIds:  []uint64{conn.Session.DeviceID},
+		Packet:     &connectpb.Packet{Command: connectpb.Command_READ_RECEIPT},
+		CloseAfter: true,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	writes, closed := tr.state()
+	assert.Zero(t, writes)
+	assert.False(t, closed)
+}
+
+func TestKickDeviceForwardsReason(t *testing.T) {
+	config.Config.Services.Connect.LocalAddr = "node-a:50055"
+
+	ctrl := gomock.NewController(t)
+	mockDevice := mocks.NewMockDeviceIntServiceClient(ctrl)
+	mockDevice.EXPECT().ListOnlineDevices(gomock.Any(), gomock.Any()).Return(&devicepb.ListOnlineDevicesReply{
+		Devices: []*devicepb.OnlineDevice{{DeviceId: 300, UserId: 3, ConnAddr: "node-d:50055"}},
+	}, nil)
+	rpc.SetDeviceIntServiceClient(mockDevice)
+
+	remote := &fakeConnectInt{}
+	rpc.SetConnectIntServiceClient("node-d:50055", remote)
+
+	service := NewConnectIntService(nil)
+	reply, err := service.KickDevice(context.Background(), &connectpb.KickDeviceRequest{UserId: 3, DeviceId: 300, Reason: "account disabled"})
+	require.NoError(t, err)
+	assert.True(t, reply.Kicked)
+	if assert.Len(t, remote.reqs, 1) {
+		assert.True(t, remote.reqs[0].CloseAfter)
+		assert.Equal(t, "account disabled", remote.reqs[0].KickReason)
+		assert.Nil(t, remote.reqs[0].Packet)
+	}
+}
+
+func TestDeliverToDevicesWritesPacket(t *testing.T) {
+	conn, tr := newTestConn(t)
+	SetConnection(conn.Session.DeviceID, conn)
+	defer conn.Close()
+
+	service := NewConnectIntService(nil)
+	reply, err := service.DeliverToDevices(context.Background(), &connectpb.DeliverToDevicesRequest{
+		DeviceIds: []uint64{conn.Session.DeviceID, 999},
+		Packet:    &connectpb.Packet{Command: connectpb.Command_RECONNECT},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), reply.Delivered)
+
+	_, closed := tr.state()
+	assert.False(t, closed)
+	assert.Equal(t, connectpb.Command_RECONNECT, lastPacket(t, tr).Command)
+}
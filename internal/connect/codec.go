@@ -0,0 +1,273 @@
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"im-server/pkg/config"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec 定义了 Packet 在连接上的编码格式，由客户端在建立 WebSocket 连接时协商。
+// Encode 的 payload 为 pkt.Data 对应的消息，回复请求时由调用方给出；为 nil 时按服务端推送的指令类型解析 data
+type Codec interface {
+	Name() string
+	Encode(pkt *connectpb.Packet, payload proto.Message) ([]byte, error)
+	Decode(buf []byte) (*connectpb.Packet, error)
+}
+
+var (
+	// ProtobufCodec 二进制 protobuf 编码，默认格式
+	ProtobufCodec Codec = protobufCodec{}
+	// JSONCodec protojson 编码，便于浏览器调试，data 按指令展开为对应消息的 JSON
+	JSONCodec Codec = jsonCodec{}
+)
+
+// subprotocolPrefix WebSocket 子协议前缀，如 im.protobuf / im.json
+const subprotocolPrefix = "im."
+
+const defaultCompressThreshold = 1024
+
+// compressThreshold 启用压缩的数据包长度下限，未开启压缩时为 0
+func compressThreshold() int {
+	if !config.Config.Services.Connect.Compression {
+		return 0
+	}
+	if n := config.Config.Services.Connect.CompressThreshold; n > 0 {
+		return n
+	}
+	return defaultCompressThreshold
+}
+
+// codecByName 按名称查找编解码器
+func codecByName(name string) (Codec, bool) {
+	switch name {
+	case ProtobufCodec.Name():
+		return ProtobufCodec, true
+	case JSONCodec.Name():
+		return JSONCodec, true
+	default:
+		return nil, false
+	}
+}
+
+// subprotocols 服务端支持的 WebSocket 子协议，用于升级时协商编码格式
+func subprotocols() []string {
+	return []string{subprotocolPrefix + ProtobufCodec.Name(), subprotocolPrefix + JSONCodec.Name()}
+}
+
+// requestedCodec 读取查询参数 codec 指定的编码格式，未指定时返回 nil
+func requestedCodec(r *http.Request) (Codec, error) {
+	name := r.URL.Query().Get("codec")
+	if name == "" {
+		return nil, nil
+	}
+	codec, ok := codecByName(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported codec %q", name)
+	}
+	return codec, nil
+}
+
+// negotiateCodec 确定连接的编码格式：查询参数优先，其次是协商成功的子协议，默认 protobuf
+func negotiateCodec(requested Codec, ws *websocket.Conn) Codec {
+	if requested != nil {
+		return requested
+	}
+	if codec, ok := codecByName(strings.TrimPrefix(ws.Subprotocol(), subprotocolPrefix)); ok {
+		return codec
+	}
+	return ProtobufCodec
+}
+
+type protobufCodec struct{}
+
+func (protobufCodec) Name() string { return "protobuf" }
+
+func (protobufCodec) Encode(pkt *connectpb.Packet, payload proto.Message) ([]byte, error) {
+	return proto.Marshal(pkt)
+}
+
+func (protobufCodec) Decode(buf []byte) (*connectpb.Packet, error) {
+	packet := new(connectpb.Packet)
+	if err := proto.Unmarshal(buf, packet); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
+// jsonPacket 是 Packet 的 JSON 表示，data 为对应消息的 JSON，无法识别的数据以 base64 字符串表示
+type jsonPacket struct {
+	Command   string          `json:"command"`
+	RequestID int64           `json:"requestId,omitempty"`
+	Code      uint32          `json:"code,omitempty"`
+	Message   string          `json:"message,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string { return "json" }
+
+func (jsonCodec) Encode(pkt *connectpb.Packet, payload proto.Message) ([]byte, error) {
+	out := jsonPacket{
+		Command:   pkt.Command.String(),
+		RequestID: pkt.RequestId,
+		Code:      pkt.Code,
+		Message:   pkt.Message,
+	}
+	var err error
+	switch {
+	case len(pkt.Data) == 0:
+	case payload != nil:
+		out.Data, err = protojson.Marshal(payload)
+	default:
+		out.Data, err = payloadToJSON(pushPayload(pkt.Command), pkt.Data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(out)
+}
+
+func (jsonCodec) Decode(buf []byte) (*connectpb.Packet, error) {
+	var in jsonPacket
+	if err := json.Unmarshal(buf, &in); err != nil {
+		return nil, err
+	}
+	command, ok := connectpb.Command_value[in.Command]
+	if !ok {
+		return nil, fmt.Errorf("unknown command %q", in.Command)
+	}
+	packet := &connectpb.Packet{
+		Command:   connectpb.Command(command),
+		RequestId: in.RequestID,
+		Code:      in.Code,
+		Message:   in.Message,
+	}
+	if len(in.Data) > 0 && string(in.Data) != "null" {
+		data, err := payloadFromJSON(upstreamPayload(packet.Command), in.Data)
+		if err != nil {
+			return nil, err
+		}
+		packet.Data = data
+	}
+	return packet, nil
+}
+
+// payloadToJSON 将 protobuf 编码的 data 转为 JSON，msg 为 nil 时以 base64 字符串输出
+func payloadToJSON(msg proto.Message, data []byte) (json.RawMessage, error) {
+	if msg == nil {
+		return json.Marshal(data)
+	}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return protojson.Marshal(msg)
+}
+
+// payloadFromJSON 将 JSON 形式的 data 转回 protobuf 编码，msg 为 nil 时按 base64 字符串解析
+func payloadFromJSON(msg proto.Message, raw json.RawMessage) ([]byte, error) {
+	if msg == nil {
+		var data []byte
+		err := json.Unmarshal(raw, &data)
+		return data, err
+	}
+	if err := protojson.Unmarshal(raw, msg); err != nil {
+		return nil, err
+	}
+	return proto.Marshal(msg)
+}
+
+// upstreamPayload 返回客户端上行指令的 data 类型
+func upstreamPayload(command connectpb.Command) proto.Message {
+	switch command {
+	case connectpb.Command_SIGN_IN:
+		return &connectpb.SignInInput{}
+	case connectpb.Command_SYNC:
+		return &connectpb.SyncInput{}
+	case connectpb.Command_MESSAGE:
+		return &messagepb.SendMessageRequest{}
+	case connectpb.Command_SUBSCRIBE_ROOM:
+		return &connectpb.SubscribeRoomInput{}
+	case connectpb.Command_READ_ACK:
+		return &connectpb.ReadAckInput{}
+	case connectpb.Command_DELIVER_ACK:
+		return &connectpb.DeliverAckInput{}
+	case connectpb.Command_SIGNAL:
+		return &connectpb.SignalInput{}
+	default:
+		return nil
+	}
+}
+
+// pushPayload 返回服务端推送指令的 data 类型，请求的回复类型由发送方在编码时给出
+func pushPayload(command connectpb.Command) proto.Message {
+	switch command {
+	case connectpb.Command_MESSAGE:
+		return &connectpb.MessageEvent{}
+	case connectpb.Command_READ_RECEIPT:
+		return &connectpb.ReadReceipt{}
+	case connectpb.Command_RECALL:
+		return &connectpb.RecallNotice{}
+	case connectpb.Command_KICKED:
+		return &connectpb.KickedNotice{}
+	case connectpb.Command_RECONNECT:
+		return &connectpb.ReconnectNotice{}
+	case connectpb.Command_SIGNAL:
+		return &connectpb.SignalEvent{}
+	default:
+		return nil
+	}
+}
+
+// encodedPacket 缓存同一个 Packet 按不同编码格式序列化的结果，
+// 向多个连接投递时每种格式只序列化一次
+type encodedPacket struct {
+	pkt  *connectpb.Packet
+	bufs map[Codec][]byte
+}
+
+func newEncodedPacket(pkt *connectpb.Packet) *encodedPacket {
+	return &encodedPacket{pkt: pkt, bufs: make(map[Codec][]byte, 1)}
+}
+
+// bytesFor 返回按连接编码格式序列化后的数据
+func (e *encodedPacket) bytesFor(c *Conn) ([]byte, error) {
+	codec := c.Codec()
+	if buf, ok := e.bufs[codec]; ok {
+		return buf, nil
+	}
+	buf, err := codec.Encode(e.pkt, nil)
+	if err != nil {
+		slog.Error("encode packet", "err", err, "codec", codec.Name(), "command", e.pkt.Command)
+		return nil, err
+	}
+	e.bufs[codec] = buf
+	return buf, nil
+}
+
+// Codec 返回连接协商的编码格式，未协商（如 TCP 连接）时为 protobuf
+func (c *Conn) Codec() Codec {
+	if c.codec == nil {
+		return ProtobufCodec
+	}
+	return c.codec
+}
+
+// writePacket 按连接的编码格式序列化并写出一个 Packet，payload 为 data 对应的消息，推送时为 nil
+func (c *Conn) writePacket(pkt *connectpb.Packet, payload proto.Message) error {
+	buf, err := c.Codec().Encode(pkt, payload)
+	if err != nil {
+		return err
+	}
+	return c.Write(buf)
+}
@@ -0,0 +1,79 @@
+package connect
+
+import (
+	"encoding/json"
+	"testing"
+
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestJSONCodecDecodeUpstream(t *testing.T) {
+	packet, err := JSONCodec.Decode([]byte(`{"command":"SIGN_IN","requestId":3,"data":{"userId":"1","deviceId":"100","token":"t1"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, connectpb.Command_SIGN_IN, packet.Command)
+	assert.Equal(t, int64(3), packet.RequestId)
+
+	var input connectpb.SignInInput
+	require.NoError(t, proto.Unmarshal(packet.Data, &input))
+	assert.Equal(t, uint64(100), input.DeviceId)
+	assert.Equal(t, "t1", input.Token)
+
+	_, err = JSONCodec.Decode([]byte(`{"command":"NOPE"}`))
+	assert.Error(t, err)
+}
+
+func TestJSONCodecEncodeDownstream(t *testing.T) {
+	data, err := proto.Marshal(&connectpb.ReadReceipt{ConversationId: "c_1_2", ReaderId: 2, ReadSeq: 5})
+	require.NoError(t, err)
+	buf, err := JSONCodec.Encode(&connectpb.Packet{Command: connectpb.Command_READ_RECEIPT, Data: data}, nil)
+	require.NoError(t, err)
+
+	var out struct {
+		Command string         `json:"command"`
+		Data    map[string]any `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf, &out))
+	assert.Equal(t, "READ_RECEIPT", out.Command)
+	assert.Equal(t, "c_1_2", out.Data["conversationId"])
+
+	// 无法识别类型的 data 以 base64 字符串往返
+	raw := &connectpb.Packet{Command: connectpb.Command_UNKNOWN, Data: []byte{1, 2, 3}}
+	buf, err = JSONCodec.Encode(raw, nil)
+	require.NoError(t, err)
+	decoded, err := JSONCodec.Decode(buf)
+	require.NoError(t, err)
+	assert.Equal(t, raw.Data, decoded.Data)
+}
+
+func TestJSONReplyUsesSenderPayload(t *testing.T) {
+	tr := &fakeTransport{}
+	conn := &Conn{Session: &Session{UserID: 1, DeviceID: 100}, Transport: tr, codec: JSONCodec}
+
+	// request_id 为 0 的 MESSAGE 回复仍按 SendMessageReply 编码，而不是推送的 MessageEvent
+	conn.Send(&connectpb.Packet{Command: connectpb.Command_MESSAGE}, &messagepb.SendMessageReply{MessageId: "m1", ClientMsgId: "c1", Seq: 7}, nil)
+	assert.JSONEq(t, `{"command":"MESSAGE","data":{"messageId":"m1","seq":"7","clientMsgId":"c1"}}`, string(tr.writes[0]))
+}
+
+func TestDeliverMixedCodecs(t *testing.T) {
+	protoConn, protoTr := newTestConn(t)
+	jsonConn := &Conn{Session: &Session{UserID: 1, DeviceID: 101}, Transport: &fakeTransport{}, codec: JSONCodec}
+	jsonTr := jsonConn.Transport.(*fakeTransport)
+	SetConnection(protoConn.Session.DeviceID, protoConn)
+	SetConnection(jsonConn.Session.DeviceID, jsonConn)
+	defer DeleteConnection(protoConn.Session.DeviceID)
+	defer DeleteConnection(jsonConn.Session.DeviceID)
+
+	data, err := proto.Marshal(&connectpb.KickedNotice{Reason: "r"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, DeliverToUser(1, &connectpb.Packet{Command: connectpb.Command_KICKED, Data: data}))
+
+	pkt, err := ProtobufCodec.Decode(protoTr.writes[0])
+	require.NoError(t, err)
+	assert.Equal(t, connectpb.Command_KICKED, pkt.Command)
+	assert.JSONEq(t, `{"command":"KICKED","data":{"reason":"r"}}`, string(jsonTr.writes[0]))
+}
@@ -6,10 +6,12 @@ import (
 	"im-server/pkg/config"
 	"im-server/pkg/protocol/pb/connectpb"
 	"im-server/pkg/protocol/pb/devicepb"
+	"im-server/pkg/protocol/pb/messagepb"
 	"im-server/pkg/rpc"
 	"log/slog"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -27,8 +29,10 @@ type Transport interface {
 
 // WSTransport 是 Transport 接口针对 WebSocket 的具体实现。
 type WSTransport struct {
-	Mutex sync.Mutex      // WS写锁，保证并发写入的线程安全
-	Ws    *websocket.Conn // 底层的 websocket 连接
+	Mutex             sync.Mutex      // WS写锁，保证并发写入的线程安全
+	Ws                *websocket.Conn // 底层的 websocket 连接
+	Text              bool            // 以文本帧写出，JSON 编码时使用
+	CompressThreshold int             // 达到该长度的数据包启用 permessage-deflate 压缩，0 表示不压缩
 }
 
 // Write 以一种线程安全的方式，为一次 WebSocket 消息写入操作设置一个短暂的超时，
@@ -40,7 +44,13 @@ func (wst *WSTransport) Write(buf []byte) error {
 	if err != nil {
 		return err
 	}
-	return wst.Ws.WriteMessage(websocket.BinaryMessage, buf)
+	// 压缩扩展未协商成功时 EnableWriteCompression 不生效
+	wst.Ws.EnableWriteCompression(wst.CompressThreshold > 0 && len(buf) >= wst.CompressThreshold)
+	messageType := websocket.BinaryMessage
+	if wst.Text {
+		messageType = websocket.TextMessage
+	}
+	return wst.Ws.WriteMessage(messageType, buf)
 }
 
 // Close 关闭底层的 WebSocket 连接。
@@ -69,6 +79,7 @@ type Session struct {
 	UserID   uint64        // 用户ID
 	DeviceID uint64        // 设备ID
 	RoomID   uint64        // 订阅的房间ID
+	Token    string        // 认证令牌，转发业务请求时携带
 	Element  *list.Element // 在管理器链表中的节点，方便快速删除
 }
 
@@ -76,17 +87,43 @@ type Session struct {
 type Conn struct {
 	Session   *Session
 	Transport Transport
+	Inflight  *Inflight // 已推送待确认的消息窗口
+
+	codec         Codec       // 协商的编码格式
+	signalLimiter rateLimiter // 瞬时信号限流
+	roomClosed    bool        // 连接已关闭，不再接受房间订阅，由 RoomManager.mu 保护
+
+	queue     chan []byte   // 有界写队列，为空时 Write 同步写出
+	done      chan struct{} // 连接关闭信号，通知写协程退出
+	flushed   chan struct{} // 写协程退出信号，此时已入队的数据均已写出
+	needSync  atomic.Bool   // 是否有推送因队列溢出被丢弃，需通知客户端同步
+	offlined  atomic.Bool   // 设备已批量登记离线（如节点排空），关闭时无需再调用 Offline
+	closeOnce sync.Once
 }
 
 // StartWSConn 是处理新 WebSocket 连接的入口函数。
 // 它创建一个 Conn 对象，并启动一个 goroutine 来服务于这个连接。
-func StartWSConn(ws *websocket.Conn, session *Session) {
+func StartWSConn(ws *websocket.Conn, session *Session, codec Codec) {
 	conn := &Conn{
-		Session:   session,
-		Transport: &WSTransport{Ws: ws},
+		Session: session,
+		Transport: &WSTransport{
+			Ws:                ws,
+			Text:              codec == JSONCodec,
+			CompressThreshold: compressThreshold(),
+		},
+		codec: codec,
 	}
+	conn.Inflight = newInflight(conn)
+	conn.startWriter()
 	// 如果 session 已包含认证后的设备信息，立刻注册，便于下行投递
 	if session != nil && session.DeviceID != 0 {
+		// 记录设备所在节点，其他节点据此将推送路由到本节点
+		err := conn.connSignIn(session.UserID, session.DeviceID, session.Token)
+		if err != nil {
+			slog.Error("conn sign in", "error", err, "userID", session.UserID, "deviceID", session.DeviceID)
+			close(conn.done) // 写协程退出时关闭底层连接
+			return
+		}
 		SetConnection(session.DeviceID, conn)
 	}
 	go conn.Serve()
@@ -101,19 +138,12 @@ func (c *Conn) SignIn(packet *connectpb.Packet) {
 	err := proto.Unmarshal(packet.Data, &signInputReq)
 	if err != nil {
 		slog.Error("unmarshal error", "error", err)
+		c.SendError(packet, connectpb.ErrorCode_ERR_INVALID_ARGUMENT, "invalid data")
 		return
 	}
 
-	//TODO
-	//使用gRPC进行远程调用函数验证登录context
-	//需要验证传入的信息是否与数据库中的符合，必然涉及repo的开发，目前先不加(7.21)
-	_, err = rpc.GetDeviceIntServiceClient().ConnSignIn(context.TODO(), &devicepb.ConnSignInRequest{
-		DeviceId:   signInputReq.DeviceId,
-		UserId:     signInputReq.UserId,
-		Token:      signInputReq.Token,
-		ConnAddr:   config.Config.Services.Connect.LocalAddr, // 使用配置中的地址
-		ClientAddr: c.Transport.RemoteAddr().String(),
-	})
+	// 使用gRPC进行远程调用，由设备服务校验 token 与设备归属并登记在线状态
+	err = c.connSignIn(signInputReq.UserId, signInputReq.DeviceId, signInputReq.Token)
 
 	// 发送登录结果给客户端
 	c.Send(packet, nil, err)
@@ -123,20 +153,94 @@ func (c *Conn) SignIn(packet *connectpb.Packet) {
 
 	c.Session.DeviceID = signInputReq.DeviceId
 	c.Session.UserID = signInputReq.UserId
+	c.Session.Token = signInputReq.Token
 
 	SetConnection(c.Session.DeviceID, c)
+	if err := c.extendDeadline(); err != nil {
+		slog.Error("set read deadline", "error", err, "deviceID", c.Session.DeviceID)
+	}
 
 	// 验证 token，更新 Session 等逻辑
 }
 
+// connSignIn 调用设备服务登记设备在线及其所在节点，并将按多端登录策略被顶替的设备踢下线
+func (c *Conn) connSignIn(userID, deviceID uint64, token string) error {
+	reply, err := rpc.GetDeviceIntServiceClient().ConnSignIn(context.TODO(), &devicepb.ConnSignInRequest{
+		DeviceId:   deviceID,
+		UserId:     userID,
+		Token:      token,
+		ConnAddr:   config.Config.Services.Connect.LocalAddr, // 使用配置中的地址
+		ClientAddr: c.Transport.RemoteAddr().String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, d := range reply.GetSuperseded() {
+		KickSuperseded(context.TODO(), d.Device, d.Reason)
+	}
+	return nil
+}
+
+// ReadAck 处理客户端的会话已读上报，转发给消息服务推进已读位置，并将结果回复给客户端
+func (c *Conn) ReadAck(packet *connectpb.Packet) {
+	var input connectpb.ReadAckInput
+	err := proto.Unmarshal(packet.Data, &input)
+	if err != nil {
+		slog.Error("unmarshal error", "error", err)
+		c.SendError(packet, connectpb.ErrorCode_ERR_INVALID_ARGUMENT, "invalid data")
+		return
+	}
+
+	ctx := rpc.WithToken(context.TODO(), c.Session.Token)
+	reply, err := rpc.GetMessageExtServiceClient().MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{
+		ConversationId: input.ConversationId,
+		ReadSeq:        input.ReadSeq,
+	})
+	c.Send(packet, reply, err)
+}
+
+// SendMessage 处理客户端上行的 MESSAGE 指令，以会话身份转发给消息服务发送，
+// 并以相同的 request_id 回复 SendMessageReply，使收发都可在同一条长连接上完成
+func (c *Conn) SendMessage(packet *connectpb.Packet) {
+	var input messagepb.SendMessageRequest
+	err := proto.Unmarshal(packet.Data, &input)
+	if err != nil {
+		slog.Error("unmarshal error", "error", err)
+		c.SendError(packet, connectpb.ErrorCode_ERR_INVALID_ARGUMENT, "invalid data")
+		return
+	}
+
+	ctx := rpc.WithToken(context.TODO(), c.Session.Token)
+	reply, err := rpc.GetMessageExtServiceClient().SendMessage(ctx, &input)
+	c.Send(packet, reply, err)
+}
+
+// DeliverAck 处理客户端的消息送达确认，停止对应消息的重传
+func (c *Conn) DeliverAck(packet *connectpb.Packet) {
+	var input connectpb.DeliverAckInput
+	err := proto.Unmarshal(packet.Data, &input)
+	if err != nil {
+		slog.Error("unmarshal error", "error", err)
+		c.SendError(packet, connectpb.ErrorCode_ERR_INVALID_ARGUMENT, "invalid data")
+		return
+	}
+	if c.Inflight == nil || !c.Inflight.Ack(input.ConversationId, input.Seq) {
+		slog.Debug("deliver ack for unknown message", "deviceID", c.Session.DeviceID, "conversation", input.ConversationId, "seq", input.Seq)
+	}
+}
+
 func (c *Conn) Send(packet *connectpb.Packet, message proto.Message, err error) {
 
 	packet.Data = nil // 这里可以根据需要设置数据
 	packet.Code = 0
 	packet.Message = ""
 
+	// 出错时只返回错误码和错误信息，不携带数据
 	if err != nil {
-
+		code, msg := errorCode(err)
+		packet.Code = uint32(code)
+		packet.Message = msg
+		message = nil
 	}
 
 	if message != nil {
@@ -148,12 +252,7 @@ func (c *Conn) Send(packet *connectpb.Packet, message proto.Message, err error)
 		packet.Data = data
 	}
 
-	buf, err := proto.Marshal(packet)
-	if err != nil {
-		slog.Error("marshal error", "error", err)
-		return
-	}
-	err = c.Write(buf)
+	err = c.writePacket(packet, message)
 	if err != nil {
 		slog.Error("write error", "error", err)
 		return
@@ -163,8 +262,11 @@ func (c *Conn) Send(packet *connectpb.Packet, message proto.Message, err error)
 
 }
 
-// Write 向连接写入数据。如果写入失败，则关闭连接。
+// Write 向连接写入数据：启用写队列时仅入队，由写协程异步写出；否则同步写出。如果写入失败，则关闭连接。
 func (c *Conn) Write(buf []byte) error {
+	if c.queue != nil {
+		return c.enqueue(buf)
+	}
 	err := c.Transport.Write(buf)
 	if err != nil {
 		c.Close()
@@ -174,10 +276,10 @@ func (c *Conn) Write(buf []byte) error {
 
 // HandleMessage 是中心消息处理器。它反序列化收到的数据包，并根据指令分发给不同的处理函数。
 func (c *Conn) HandleMessage(buf []byte) {
-	var packet = new(connectpb.Packet)
-	err := proto.Unmarshal(buf, packet)
+	packet, err := c.Codec().Decode(buf)
 	if err != nil {
 		slog.Error("unmarshal error", "error", err, "len", len(buf))
+		c.SendError(&connectpb.Packet{}, connectpb.ErrorCode_ERR_BAD_PACKET, "malformed packet")
 		return
 	}
 	slog.Info("HandleMessage111", "command", packet.Command, "requestId", packet.RequestId, "dataLen", len(packet.Data), "bufLen", len(buf))
@@ -186,54 +288,83 @@ func (c *Conn) HandleMessage(buf []byte) {
 	// 检查除了登录指令外的所有请求是否已经认证
 	if packet.Command != connectpb.Command_SIGN_IN && c.Session.UserID == 0 {
 		slog.Error("unauthorized command", "command", packet.Command)
+		c.SendError(packet, connectpb.ErrorCode_ERR_NOT_SIGNED_IN, "sign in required")
 		return
 	}
 	switch packet.Command {
 	case connectpb.Command_SIGN_IN:
 		c.SignIn(packet)
+	case connectpb.Command_MESSAGE:
+		c.SendMessage(packet)
+	case connectpb.Command_READ_ACK:
+		c.ReadAck(packet)
+	case connectpb.Command_HEARTBEAT:
+		c.Heartbeat(packet)
+	case connectpb.Command_SUBSCRIBE_ROOM:
+		c.SubscribeRoom(packet)
+	case connectpb.Command_SYNC:
+		c.Sync(packet)
+	case connectpb.Command_DELIVER_ACK:
+		c.DeliverAck(packet)
+	case connectpb.Command_SIGNAL:
+		c.Signal(packet)
 
 	default:
-		slog.Error("handler switch other")
+		slog.Error("handler switch other", "command", packet.Command)
+		c.SendError(packet, connectpb.ErrorCode_ERR_UNKNOWN_COMMAND, "unsupported command")
 	}
 }
 
-// Close 关闭一个连接，并执行相关的清理工作。
+// Close 关闭一个连接，并执行相关的清理工作。重复调用只会执行一次。
 func (c *Conn) Close() {
+	c.closeOnce.Do(c.close)
+}
+
+func (c *Conn) close() {
 
 	// 如果设备已登录，则从全局连接管理器中删除此连接
 	if c.Session.DeviceID != 0 {
-		DeleteConnection(c.Session.DeviceID)
+		removeConnection(c.Session.DeviceID, c)
+	}
+
+	// 停止未确认消息的重传，剩余消息由客户端重连后离线同步补齐
+	if c.Inflight != nil {
+		c.Inflight.Stop()
 	}
 
-	//TO DO
-	// 取消订阅房间
+	// 取消订阅房间，此后的订阅请求一律拒绝
+	closeRoom(c)
 
-	//TO DO
 	// gPRC远程调用函数，使得设备离线
-	_, err := rpc.GetDeviceIntServiceClient().Offline(context.TODO(), &devicepb.OfflineRequest{
-		UserId:     c.Session.UserID,
-		DeviceId:   c.Session.DeviceID,
-		ClientAddr: c.Transport.RemoteAddr().String(),
-	})
+	if !c.offlined.Load() {
+		_, err := rpc.GetDeviceIntServiceClient().Offline(context.TODO(), &devicepb.OfflineRequest{
+			UserId:     c.Session.UserID,
+			DeviceId:   c.Session.DeviceID,
+			ClientAddr: c.Transport.RemoteAddr().String(),
+		})
 
-	if err != nil {
-		slog.Error("offline error", "error", err, "userID", c.Session.UserID, "deviceID", c.Session.DeviceID, "clientAddr", c.Transport.RemoteAddr().String())
+		if err != nil {
+			slog.Error("offline error", "error", err, "userID", c.Session.UserID, "deviceID", c.Session.DeviceID, "clientAddr", c.Transport.RemoteAddr().String())
+		}
 	}
 
-	// 关闭底层的物理连接
+	// 关闭底层的物理连接；启用写队列时由写协程写完已入队的数据后关闭
+	if c.done != nil {
+		close(c.done)
+		return
+	}
 	c.Transport.Close()
 }
 
 // Serve 是每个连接的主服务循环。
-// 它在一个无限循环中不断地设置超时、读取消息，并将消息分发给 HandleMessage 处理。
+// 它先设置初始读超时，再在一个无限循环中读取消息，并将消息分发给 HandleMessage 处理；读超时只由登录和心跳延长。
 // 当发生任何错误时，循环终止，连接被关闭。
 func (c *Conn) Serve() {
+	if err := c.extendDeadline(); err != nil {
+		c.Close()
+		return
+	}
 	for {
-		err := c.Transport.SetReadDeadline(time.Now().Add(12 * time.Minute))
-		if err != nil {
-			c.Close()
-			return
-		}
 		data, err := c.Transport.ReadMessage() // 读取消息
 		if err != nil {
 			c.Close()
@@ -242,3 +373,9 @@ func (c *Conn) Serve() {
 		c.HandleMessage(data)
 	}
 }
+
+// extendDeadline 延长读超时。只有登录和心跳会延长，与设备在线状态的续期保持一致：
+// 只发送业务数据包、不发心跳的连接会在空闲超时后断开，而不是连接仍在、在线状态却已过期无法被路由
+func (c *Conn) extendDeadline() error {
+	return c.Transport.SetReadDeadline(time.Now().Add(idleTimeout()))
+}
@@ -0,0 +1,60 @@
+package connect
+
+import (
+	"context"
+	"testing"
+
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/messagepb"
+	"im-server/pkg/rpc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeMessageExt 记录转发的发送请求及其携带的认证信息
+type fakeMessageExt struct {
+	messagepb.MessageExtServiceClient
+	req           *messagepb.SendMessageRequest
+	authorization []string
+}
+
+func (f *fakeMessageExt) SendMessage(ctx context.Context, in *messagepb.SendMessageRequest, opts ...grpc.CallOption) (*messagepb.SendMessageReply, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	f.req = in
+	f.authorization = md.Get("authorization")
+	return &messagepb.SendMessageReply{MessageId: "m1", ConversationId: "c_1_2", Seq: 7}, nil
+}
+
+func TestSendMessageUpstream(t *testing.T) {
+	fake := &fakeMessageExt{}
+	rpc.SetMessageExtServiceClient(fake)
+
+	conn, tr := newTestConn(t)
+	conn.Session.Token = "t1"
+	data, err := proto.Marshal(&messagepb.SendMessageRequest{ClientMsgId: "cm1", RecipientId: 2})
+	require.NoError(t, err)
+	buf, err := proto.Marshal(&connectpb.Packet{Command: connectpb.Command_MESSAGE, RequestId: 42, Data: data})
+	require.NoError(t, err)
+
+	conn.HandleMessage(buf)
+
+	require.NotNil(t, fake.req)
+	assert.Equal(t, "cm1", fake.req.ClientMsgId)
+	assert.Equal(t, []string{"Bearer t1"}, fake.authorization)
+
+	writes, _ := tr.state()
+	require.Equal(t, 1, writes)
+	var pkt connectpb.Packet
+	require.NoError(t, proto.Unmarshal(tr.writes[0], &pkt))
+	assert.Equal(t, connectpb.Command_MESSAGE, pkt.Command)
+	assert.Equal(t, int64(42), pkt.RequestId)
+	var reply messagepb.SendMessageReply
+	require.NoError(t, proto.Unmarshal(pkt.Data, &reply))
+	assert.Equal(t, int64(7), reply.Seq)
+	// 回复不是下行推送，不进入待确认窗口
+	assert.Equal(t, 0, conn.Inflight.Len())
+}
@@ -0,0 +1,164 @@
+package connect
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/devicepb"
+	"im-server/pkg/rpc"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultDrainTimeout    = 30 * time.Second
+	defaultReconnectWindow = 10 * time.Second
+	// offlineBatchSize 单次 BatchOffline 调用携带的设备数
+	offlineBatchSize = 500
+	// reasonNodeDraining 节点停机时下发给客户端的重连原因
+	reasonNodeDraining = "服务节点维护，请重新连接"
+)
+
+// DrainTimeout 停机排空的最长时间，超时后剩余连接被强制关闭
+func DrainTimeout() time.Duration {
+	if d, err := time.ParseDuration(config.Config.Services.Connect.DrainTimeout); err == nil && d > 0 {
+		return d
+	}
+	return defaultDrainTimeout
+}
+
+// reconnectWindow 客户端重连延迟的打散区间，避免全部客户端同时涌向其他节点
+func reconnectWindow() time.Duration {
+	if d, err := time.ParseDuration(config.Config.Services.Connect.ReconnectWindow); err == nil && d > 0 {
+		return d
+	}
+	return defaultReconnectWindow
+}
+
+var (
+	draining    atomic.Bool
+	listenersMu sync.Mutex
+	listeners   []io.Closer
+	// liveConns 本节点全部存活的连接（含尚未登录的连接），写协程启动时登记、退出时移除
+	liveConns sync.Map
+)
+
+// Draining 返回节点是否处于停机排空状态，排空期间不再接受新连接
+func Draining() bool {
+	return draining.Load()
+}
+
+// registerListener 登记长连接服务的监听器，排空时统一关闭
+func registerListener(l io.Closer) {
+	listenersMu.Lock()
+	defer listenersMu.Unlock()
+	listeners = append(listeners, l)
+}
+
+// trackConn 登记存活的连接
+func trackConn(c *Conn) {
+	liveConns.Store(c, struct{}{})
+}
+
+// untrackConn 移除已关闭的连接
+func untrackConn(c *Conn) {
+	liveConns.Delete(c)
+}
+
+// Drain 将本节点排空以便停机，调用前应先停止 Kafka 投递消费，避免向正在关闭的连接推送：
+// 停止接受新连接，向每个已登录的客户端下发携带打散延迟的 RECONNECT 通知，批量登记设备离线，
+// 再关闭全部连接（含尚未登录的连接）并等待写队列中的数据写完，ctx 到期后强制关闭剩余连接。
+func Drain(ctx context.Context) {
+	// 1. 停止接受新连接
+	draining.Store(true)
+	listenersMu.Lock()
+	for _, l := range listeners {
+		if err := l.Close(); err != nil {
+			slog.Error("close listener", "err", err)
+		}
+	}
+	listeners = nil
+	listenersMu.Unlock()
+
+	var conns []*Conn
+	signedIn := make(map[*Conn]bool)
+	ConnectManager.Range(func(key, value any) bool {
+		conn := value.(*Conn)
+		conns = append(conns, conn)
+		signedIn[conn] = true
+		return true
+	})
+	// 尚未登录的连接没有设备需要离线，直接关闭底层连接，由其读循环完成清理
+	var pending int
+	liveConns.Range(func(key, value any) bool {
+		if conn := key.(*Conn); !signedIn[conn] {
+			_ = conn.Transport.Close()
+			pending++
+		}
+		return true
+	})
+	slog.Info("connect draining", "conns", len(conns), "pending", pending)
+
+	// 2. 下发 RECONNECT，重连延迟在打散区间内均匀分布
+	window := reconnectWindow()
+	for i, conn := range conns {
+		delay := window * time.Duration(i) / time.Duration(len(conns))
+		_ = conn.writePacket(reconnectPacket(delay), nil)
+	}
+
+	// 3. 批量登记设备离线，关闭连接时不再逐个调用 Offline
+	batchOffline(ctx, conns)
+
+	// 4. 关闭连接，写协程写完已入队的数据后关闭底层连接
+	for _, conn := range conns {
+		conn.offlined.Store(true)
+		conn.Close()
+	}
+	for _, conn := range conns {
+		if conn.flushed == nil {
+			continue
+		}
+		select {
+		case <-conn.flushed:
+		case <-ctx.Done():
+			// 5. 超时仍未写完的连接直接关闭
+			slog.Warn("drain deadline exceeded, force close", "deviceID", conn.Session.DeviceID, "depth", conn.QueueDepth())
+			_ = conn.Transport.Close()
+		}
+	}
+	slog.Info("connect drained", "conns", len(conns))
+}
+
+// batchOffline 分批调用设备服务将连接对应的设备登记为离线
+func batchOffline(ctx context.Context, conns []*Conn) {
+	devices := make([]*devicepb.OfflineRequest, 0, len(conns))
+	for _, conn := range conns {
+		devices = append(devices, &devicepb.OfflineRequest{
+			UserId:     conn.Session.UserID,
+			DeviceId:   conn.Session.DeviceID,
+			ClientAddr: conn.Transport.RemoteAddr().String(),
+		})
+	}
+	for start := 0; start < len(devices); start += offlineBatchSize {
+		end := min(start+offlineBatchSize, len(devices))
+		_, err := rpc.GetDeviceIntServiceClient().BatchOffline(ctx, &devicepb.BatchOfflineRequest{Devices: devices[start:end]})
+		if err != nil {
+			slog.Error("batch offline", "err", err, "devices", end-start)
+		}
+	}
+}
+
+// reconnectPacket 构造携带建议重连延迟的 RECONNECT 通知
+func reconnectPacket(delay time.Duration) *connectpb.Packet {
+	data, _ := proto.Marshal(&connectpb.ReconnectNotice{
+		RetryAfterMs: uint32(delay.Milliseconds()),
+		Reason:       reasonNodeDraining,
+	})
+	return &connectpb.Packet{Command: connectpb.Command_RECONNECT, Data: data}
+}
@@ -0,0 +1,103 @@
+package connect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/devicepb"
+	"im-server/pkg/rpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDrain(t *testing.T) {
+	defer draining.Store(false)
+
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockDeviceIntServiceClient(ctrl)
+	// 排空时批量离线，关闭连接时不再逐个调用 Offline
+	mockClient.EXPECT().BatchOffline(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *devicepb.BatchOfflineRequest, _ ...any) (any, error) {
+			assert.Len(t, req.Devices, 2)
+			return nil, nil
+		})
+	rpc.SetDeviceIntServiceClient(mockClient)
+
+	transports := make([]*fakeTransport, 2)
+	for i := range transports {
+		transports[i] = &fakeTransport{}
+		conn := &Conn{Session: &Session{UserID: 1, DeviceID: uint64(200 + i)}, Transport: transports[i]}
+		conn.Inflight = newInflight(conn)
+		conn.startWriter()
+		SetConnection(conn.Session.DeviceID, conn)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	Drain(ctx)
+
+	assert.True(t, Draining())
+	assert.Empty(t, userConnections(1))
+	var delays []uint32
+	for _, tr := range transports {
+		writes, closed := tr.state()
+		require.Equal(t, 1, writes)
+		assert.True(t, closed)
+
+		var pkt connectpb.Packet
+		require.NoError(t, proto.Unmarshal(tr.writes[0], &pkt))
+		assert.Equal(t, connectpb.Command_RECONNECT, pkt.Command)
+		var notice connectpb.ReconnectNotice
+		require.NoError(t, proto.Unmarshal(pkt.Data, &notice))
+		delays = append(delays, notice.RetryAfterMs)
+	}
+	// 重连延迟被打散到不同的时间点
+	assert.NotEqual(t, delays[0], delays[1])
+}
+
+func TestDrainClosesPendingConns(t *testing.T) {
+	defer draining.Store(false)
+
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockDeviceIntServiceClient(ctrl)
+	mockClient.EXPECT().BatchOffline(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *devicepb.BatchOfflineRequest, _ ...any) (any, error) {
+			assert.Len(t, req.Devices, 1)
+			return nil, nil
+		})
+	rpc.SetDeviceIntServiceClient(mockClient)
+
+	signedTr := &fakeTransport{}
+	signed := &Conn{Session: &Session{UserID: 1, DeviceID: 300}, Transport: signedTr}
+	signed.Inflight = newInflight(signed)
+	signed.startWriter()
+	SetConnection(signed.Session.DeviceID, signed)
+
+	// 尚未登录的连接不在连接管理器中，同样需要在排空时关闭
+	pendingTr := &fakeTransport{}
+	pending := &Conn{Session: &Session{}, Transport: pendingTr}
+	pending.Inflight = newInflight(pending)
+	pending.startWriter()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	Drain(ctx)
+
+	writes, closed := pendingTr.state()
+	assert.Zero(t, writes)
+	assert.True(t, closed)
+	writes, closed = signedTr.state()
+	assert.Equal(t, 1, writes)
+	assert.True(t, closed)
+
+	// 写协程退出后连接不再登记为存活
+	<-signed.flushed
+	_, ok := liveConns.Load(signed)
+	assert.False(t, ok)
+}
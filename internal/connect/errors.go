@@ -0,0 +1,65 @@
+package connect
+
+import (
+	"errors"
+
+	"im-server/pkg/protocol/pb/connectpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcErrorCodes gRPC 状态码到连接层错误码的映射
+var grpcErrorCodes = map[codes.Code]connectpb.ErrorCode{
+	codes.OK:                 connectpb.ErrorCode_OK,
+	codes.InvalidArgument:    connectpb.ErrorCode_ERR_INVALID_ARGUMENT,
+	codes.OutOfRange:         connectpb.ErrorCode_ERR_INVALID_ARGUMENT,
+	codes.Unauthenticated:    connectpb.ErrorCode_ERR_UNAUTHENTICATED,
+	codes.PermissionDenied:   connectpb.ErrorCode_ERR_PERMISSION_DENIED,
+	codes.NotFound:           connectpb.ErrorCode_ERR_NOT_FOUND,
+	codes.AlreadyExists:      connectpb.ErrorCode_ERR_ALREADY_EXISTS,
+	codes.ResourceExhausted:  connectpb.ErrorCode_ERR_RESOURCE_EXHAUSTED,
+	codes.FailedPrecondition: connectpb.ErrorCode_ERR_FAILED_PRECONDITION,
+	codes.Aborted:            connectpb.ErrorCode_ERR_FAILED_PRECONDITION,
+	codes.Unavailable:        connectpb.ErrorCode_ERR_UNAVAILABLE,
+	codes.DeadlineExceeded:   connectpb.ErrorCode_ERR_DEADLINE_EXCEEDED,
+	codes.Canceled:           connectpb.ErrorCode_ERR_DEADLINE_EXCEEDED,
+	codes.Internal:           connectpb.ErrorCode_ERR_INTERNAL,
+	codes.DataLoss:           connectpb.ErrorCode_ERR_INTERNAL,
+	codes.Unimplemented:      connectpb.ErrorCode_ERR_UNKNOWN_COMMAND,
+}
+
+// errorCode 将处理请求时的错误转换为返回给客户端的错误码和错误信息。
+// 非 gRPC 状态的错误视为未知错误；内部错误不向客户端暴露细节
+func errorCode(err error) (connectpb.ErrorCode, string) {
+	var pe *packetError
+	if errors.As(err, &pe) {
+		return pe.code, pe.message
+	}
+	st := status.Convert(err)
+	code, ok := grpcErrorCodes[st.Code()]
+	if !ok {
+		code = connectpb.ErrorCode_ERR_UNKNOWN
+	}
+	switch code {
+	case connectpb.ErrorCode_ERR_INTERNAL, connectpb.ErrorCode_ERR_UNKNOWN:
+		return code, "internal error"
+	default:
+		return code, st.Message()
+	}
+}
+
+// SendError 回复一个携带错误码的数据包，用于连接层自身产生的错误（如未登录、指令不支持）
+func (c *Conn) SendError(packet *connectpb.Packet, code connectpb.ErrorCode, message string) {
+	c.Send(packet, nil, &packetError{code: code, message: message})
+}
+
+// packetError 连接层的错误，直接携带返回给客户端的错误码
+type packetError struct {
+	code    connectpb.ErrorCode
+	message string
+}
+
+func (e *packetError) Error() string {
+	return e.code.String() + ": " + e.message
+}
@@ -0,0 +1,104 @@
+package connect
+
+import (
+	"errors"
+	"testing"
+
+	"im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/rpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err     error
+		code    connectpb.ErrorCode
+		message string
+	}{
+		{status.Error(codes.Unauthenticated, "invalid token"), connectpb.ErrorCode_ERR_UNAUTHENTICATED, "invalid token"},
+		{status.Error(codes.PermissionDenied, "not a member"), connectpb.ErrorCode_ERR_PERMISSION_DENIED, "not a member"},
+		{status.Error(codes.Internal, "load message index: boom"), connectpb.ErrorCode_ERR_INTERNAL, "internal error"},
+		{errors.New("boom"), connectpb.ErrorCode_ERR_UNKNOWN, "internal error"},
+		{&packetError{code: connectpb.ErrorCode_ERR_NOT_SIGNED_IN, message: "sign in required"}, connectpb.ErrorCode_ERR_NOT_SIGNED_IN, "sign in required"},
+	}
+	for _, tt := range tests {
+		code, message := errorCode(tt.err)
+		assert.Equal(t, tt.code, code, tt.err.Error())
+		assert.Equal(t, tt.message, message, tt.err.Error())
+	}
+}
+
+// lastPacket 解码连接最后写出的数据包
+func lastPacket(t *testing.T, tr *fakeTransport) *connectpb.Packet {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	require.NotEmpty(t, tr.writes)
+	var pkt connectpb.Packet
+	require.NoError(t, proto.Unmarshal(tr.writes[len(tr.writes)-1], &pkt))
+	return &pkt
+}
+
+func TestHandleMessageErrors(t *testing.T) {
+	conn, tr := newTestConn(t)
+	conn.Session.UserID = 0
+
+	// 未登录时发送其他指令
+	buf, err := proto.Marshal(&connectpb.Packet{Command: connectpb.Command_SYNC, RequestId: 5})
+	require.NoError(t, err)
+	conn.HandleMessage(buf)
+	pkt := lastPacket(t, tr)
+	assert.Equal(t, int64(5), pkt.RequestId)
+	assert.Equal(t, uint32(connectpb.ErrorCode_ERR_NOT_SIGNED_IN), pkt.Code)
+
+	// 无法解析的数据包
+	conn.HandleMessage([]byte{0xff, 0xff})
+	assert.Equal(t, uint32(connectpb.ErrorCode_ERR_BAD_PACKET), lastPacket(t, tr).Code)
+
+	// 登录后发送不支持的指令
+	conn.Session.UserID = 1
+	buf, err = proto.Marshal(&connectpb.Packet{Command: connectpb.Command_READ_RECEIPT, RequestId: 6})
+	require.NoError(t, err)
+	conn.HandleMessage(buf)
+	assert.Equal(t, uint32(connectpb.ErrorCode_ERR_UNKNOWN_COMMAND), lastPacket(t, tr).Code)
+}
+
+func TestSignInFailureReturnsCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode connectpb.ErrorCode
+	}{
+		{name: "invalid token", err: status.Error(codes.Unauthenticated, "invalid token"), wantCode: connectpb.ErrorCode_ERR_UNAUTHENTICATED},
+		{name: "device of another user", err: status.Error(codes.PermissionDenied, "device does not belong to user"), wantCode: connectpb.ErrorCode_ERR_PERMISSION_DENIED},
+		{name: "unknown device", err: status.Error(codes.NotFound, "device not found"), wantCode: connectpb.ErrorCode_ERR_NOT_FOUND},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mocks.NewMockDeviceIntServiceClient(ctrl)
+			mockClient.EXPECT().ConnSignIn(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+			rpc.SetDeviceIntServiceClient(mockClient)
+
+			tr := &fakeTransport{}
+			conn := &Conn{Session: &Session{}, Transport: tr}
+			data, err := proto.Marshal(&connectpb.SignInInput{UserId: 1, DeviceId: 100, Token: "t"})
+			require.NoError(t, err)
+			buf, err := proto.Marshal(&connectpb.Packet{Command: connectpb.Command_SIGN_IN, RequestId: 1, Data: data})
+			require.NoError(t, err)
+			conn.HandleMessage(buf)
+
+			pkt := lastPacket(t, tr)
+			assert.Equal(t, uint32(tt.wantCode), pkt.Code)
+			assert.Equal(t, status.Convert(tt.err).Message(), pkt.Message)
+			assert.Zero(t, conn.Session.UserID)
+			assert.Nil(t, GetConnection(100))
+		})
+	}
+}
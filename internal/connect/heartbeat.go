@@ -0,0 +1,62 @@
+package connect
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/devicepb"
+	"im-server/pkg/rpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultHeartbeatInterval = 30 * time.Second
+	defaultIdleTimeout       = 12 * time.Minute
+)
+
+// heartbeatInterval 建议客户端发送心跳的间隔
+func heartbeatInterval() time.Duration {
+	if d, err := time.ParseDuration(config.Config.Services.Connect.HeartbeatInterval); err == nil && d > 0 {
+		return d
+	}
+	return defaultHeartbeatInterval
+}
+
+// idleTimeout 连接空闲超时，超过该时间未收到心跳则断开连接
+func idleTimeout() time.Duration {
+	if d, err := time.ParseDuration(config.Config.Services.Connect.IdleTimeout); err == nil && d > 0 {
+		return d
+	}
+	return defaultIdleTimeout
+}
+
+// Heartbeat 处理客户端心跳：延长读超时，续期设备在线状态，并回复携带服务端时间的 pong
+func (c *Conn) Heartbeat(packet *connectpb.Packet) {
+	now := time.Now()
+	if err := c.extendDeadline(); err != nil {
+		slog.Error("set read deadline", "error", err, "deviceID", c.Session.DeviceID)
+	}
+
+	_, err := rpc.GetDeviceIntServiceClient().Heartbeat(context.TODO(), &devicepb.HeartbeatRequest{
+		UserId:   c.Session.UserID,
+		DeviceId: c.Session.DeviceID,
+	})
+	if err != nil {
+		slog.Error("refresh device online", "error", err, "userID", c.Session.UserID, "deviceID", c.Session.DeviceID)
+		// 在线状态已过期，通知客户端重新登录
+		if status.Code(err) == codes.NotFound {
+			c.Send(packet, nil, err)
+			return
+		}
+	}
+
+	c.Send(packet, &connectpb.HeartbeatOutput{
+		ServerTime:        now.UnixMilli(),
+		HeartbeatInterval: uint32(heartbeatInterval() / time.Second),
+	}, nil)
+}
@@ -0,0 +1,89 @@
+package connect
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/rpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestHeartbeatReplyPong(t *testing.T) {
+	config.Config.Services.Connect.HeartbeatInterval = "20s"
+	defer func() { config.Config.Services.Connect.HeartbeatInterval = "" }()
+
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockDeviceIntServiceClient(ctrl)
+	mockClient.EXPECT().Heartbeat(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	rpc.SetDeviceIntServiceClient(mockClient)
+
+	tr := &fakeTransport{}
+	conn := &Conn{Session: &Session{UserID: 1, DeviceID: 100}, Transport: tr}
+
+	before := time.Now().UnixMilli()
+	conn.Heartbeat(&connectpb.Packet{Command: connectpb.Command_HEARTBEAT, RequestId: 7})
+
+	require.Len(t, tr.writes, 1)
+	var pkt connectpb.Packet
+	require.NoError(t, proto.Unmarshal(tr.writes[0], &pkt))
+	assert.Equal(t, connectpb.Command_HEARTBEAT, pkt.Command)
+	assert.Equal(t, int64(7), pkt.RequestId)
+
+	var pong connectpb.HeartbeatOutput
+	require.NoError(t, proto.Unmarshal(pkt.Data, &pong))
+	assert.GreaterOrEqual(t, pong.ServerTime, before)
+	assert.Equal(t, uint32(20), pong.HeartbeatInterval)
+}
+
+// deadlineTransport 按顺序返回预置的数据包，并记录每次设置的读超时
+type deadlineTransport struct {
+	fakeTransport
+	reads     [][]byte
+	deadlines []time.Time
+}
+
+func (t *deadlineTransport) SetReadDeadline(d time.Time) error {
+	t.deadlines = append(t.deadlines, d)
+	return nil
+}
+
+func (t *deadlineTransport) ReadMessage() ([]byte, error) {
+	if len(t.reads) == 0 {
+		return nil, net.ErrClosed
+	}
+	buf := t.reads[0]
+	t.reads = t.reads[1:]
+	return buf, nil
+}
+
+func TestServeExtendsDeadlineOnlyOnHeartbeat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockDeviceIntServiceClient(ctrl)
+	mockClient.EXPECT().Heartbeat(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	mockClient.EXPECT().Offline(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	rpc.SetDeviceIntServiceClient(mockClient)
+
+	encode := func(cmd connectpb.Command) []byte {
+		buf, err := proto.Marshal(&connectpb.Packet{Command: cmd})
+		require.NoError(t, err)
+		return buf
+	}
+	tr := &deadlineTransport{reads: [][]byte{
+		encode(connectpb.Command_DELIVER_ACK),
+		encode(connectpb.Command_DELIVER_ACK),
+		encode(connectpb.Command_HEARTBEAT),
+	}}
+	conn := &Conn{Session: &Session{UserID: 1, DeviceID: 100}, Transport: tr}
+	conn.Serve()
+
+	// 初始设置一次，业务数据包不延长，心跳延长一次
+	assert.Len(t, tr.deadlines, 2)
+}
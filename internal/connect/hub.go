@@ -1,6 +1,7 @@
 package connect
 
 import (
+	"errors"
 	"log/slog"
 	"sync"
 
@@ -11,8 +12,60 @@ import (
 
 var ConnectManager = sync.Map{}
 
+// userShardCount 用户索引的分片数，分散不同用户之间的锁竞争
+const userShardCount = 64
+
+// userShard 是用户 -> 设备连接索引的一个分片
+type userShard struct {
+	mu      sync.RWMutex
+	devices map[uint64]map[uint64]*Conn // userID -> deviceID -> Conn
+}
+
+// userIndex 与 ConnectManager 并行维护的按用户分片的连接索引，
+// 使按用户投递的开销与该用户的设备数成正比，而不是与本节点的总连接数成正比
+var userIndex = func() *[userShardCount]userShard {
+	var shards [userShardCount]userShard
+	for i := range shards {
+		shards[i].devices = make(map[uint64]map[uint64]*Conn)
+	}
+	return &shards
+}()
+
+func userShardOf(userID uint64) *userShard {
+	return &userIndex[userID%userShardCount]
+}
+
+// reasonDeviceReconnected 同一设备在本节点重连时旧连接收到的下线原因
+const reasonDeviceReconnected = "同一设备在其他连接重新登录"
+
+// errInflightFull 待确认窗口已满，客户端长期未确认推送
+var errInflightFull = errors.New("inflight window full")
+
+// SetConnection 注册设备连接，同时维护用户索引；同一设备的旧连接会被替换
 func SetConnection(deviceID uint64, conn *Conn) {
-	ConnectManager.Store(deviceID, conn)
+	userID := conn.Session.UserID
+	shard := userShardOf(userID)
+	shard.mu.Lock()
+	prev, loaded := ConnectManager.Swap(deviceID, conn)
+	if shard.devices[userID] == nil {
+		shard.devices[userID] = make(map[uint64]*Conn)
+	}
+	shard.devices[userID][deviceID] = conn
+	shard.mu.Unlock()
+
+	if !loaded {
+		return
+	}
+	old := prev.(*Conn)
+	if old == conn {
+		return
+	}
+	// 设备此前登录的是其他用户时，从旧用户的索引中移除
+	if old.Session.UserID != userID {
+		unindexConnection(old.Session.UserID, deviceID, old)
+	}
+	// 同一设备重连，旧连接不再可达，通知其下线并关闭
+	old.Kick(reasonDeviceReconnected)
 }
 
 func GetConnection(deviceID uint64) *Conn {
@@ -23,7 +76,43 @@ func GetConnection(deviceID uint64) *Conn {
 }
 
 func DeleteConnection(deviceID uint64) {
-	ConnectManager.Delete(deviceID)
+	if conn, ok := ConnectManager.LoadAndDelete(deviceID); ok {
+		old := conn.(*Conn)
+		unindexConnection(old.Session.UserID, deviceID, old)
+	}
+}
+
+// removeConnection 仅当设备当前注册的仍是该连接时才将其移除，避免旧连接关闭时误删设备重连后的新连接
+func removeConnection(deviceID uint64, conn *Conn) {
+	if ConnectManager.CompareAndDelete(deviceID, conn) {
+		unindexConnection(conn.Session.UserID, deviceID, conn)
+	}
+}
+
+// unindexConnection 从用户索引中移除设备连接
+func unindexConnection(userID, deviceID uint64, conn *Conn) {
+	shard := userShardOf(userID)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	if devices := shard.devices[userID]; devices[deviceID] == conn {
+		delete(devices, deviceID)
+		if len(devices) == 0 {
+			delete(shard.devices, userID)
+		}
+	}
+}
+
+// userConnections 返回用户在本节点上的全部连接
+func userConnections(userID uint64) []*Conn {
+	shard := userShardOf(userID)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	devices := shard.devices[userID]
+	conns := make([]*Conn, 0, len(devices))
+	for _, conn := range devices {
+		conns = append(conns, conn)
+	}
+	return conns
 }
 
 // DeliverToDevice 将一个 Packet 直接发送到某个设备连接
@@ -33,39 +122,84 @@ func DeliverToDevice(deviceID uint64, pkt *connectpb.Packet) bool {
 		slog.Info("device offline, skip", "deviceID", deviceID)
 		return false
 	}
-	buf, err := proto.Marshal(pkt)
-	if err != nil {
-		slog.Error("marshal packet", "err", err)
+	if err := c.deliver(newEncodedPacket(pkt)); err != nil {
+		slog.Error("write packet", "err", err, "deviceID", deviceID)
 		return false
 	}
-	if err := c.Write(buf); err != nil {
-		slog.Error("write packet", "err", err, "deviceID", deviceID)
+	return true
+}
+
+// kickDevice 将本节点上的某个设备踢下线
+func kickDevice(deviceID uint64, reason string) bool {
+	c := GetConnection(deviceID)
+	if c == nil {
+		slog.Info("device offline, skip", "deviceID", deviceID)
 		return false
 	}
+	c.Kick(reason)
 	return true
 }
 
 // DeliverToUser 按用户ID向其所有在线设备广播一个 Packet
 func DeliverToUser(userID uint64, pkt *connectpb.Packet) int {
-	buf, err := proto.Marshal(pkt)
-	if err != nil {
-		slog.Error("marshal packet", "err", err)
-		return 0
-	}
+	ep := newEncodedPacket(pkt)
 	count := 0
-	ConnectManager.Range(func(key, value any) bool {
-		conn := value.(*Conn)
-		if conn.Session != nil && conn.Session.UserID == userID {
-			if err := conn.Write(buf); err == nil {
-				count++
-			} else {
-				slog.Error("write packet", "err", err, "deviceID", key)
-			}
+	for _, conn := range userConnections(userID) {
+		if err := conn.deliver(ep); err == nil {
+			count++
+		} else {
+			slog.Error("write packet", "err", err, "deviceID", conn.Session.DeviceID)
 		}
-		return true
-	})
+	}
 	if count == 0 {
 		slog.Info("no online devices for user", "userID", userID)
 	}
 	return count
 }
+
+// deliver 按连接的编码格式写出 Packet。MESSAGE 推送需要客户端以 DELIVER_ACK 确认，
+// 写出前先登记到待确认窗口；窗口已满时关闭连接，客户端重连后走离线同步
+func (c *Conn) deliver(ep *encodedPacket) error {
+	buf, err := ep.bytesFor(c)
+	if err != nil {
+		return err
+	}
+	convID, seq, tracked := ackKey(ep.pkt)
+	if tracked && c.Inflight != nil {
+		if !c.Inflight.Track(convID, seq, buf) {
+			c.Close()
+			return errInflightFull
+		}
+	}
+	err = c.Write(buf)
+	if errors.Is(err, errWriteQueueFull) && tracked && c.Inflight != nil {
+		// 因队列溢出未推送的消息改由离线同步补齐，不再重传
+		c.Inflight.Ack(convID, seq)
+	}
+	return err
+}
+
+// ackKey 返回 MESSAGE 推送的确认键 (conversation_id, seq)，其他指令无需确认
+func ackKey(pkt *connectpb.Packet) (string, int64, bool) {
+	if pkt.Command != connectpb.Command_MESSAGE {
+		return "", 0, false
+	}
+	var event connectpb.MessageEvent
+	if err := proto.Unmarshal(pkt.Data, &event); err != nil || event.ConversationId == "" {
+		return "", 0, false
+	}
+	return event.ConversationId, event.Seq, true
+}
+
+// Kick 下发携带原因的 KICKED 通知后关闭连接
+func (c *Conn) Kick(reason string) {
+	slog.Info("kick connection", "userID", c.Session.UserID, "deviceID", c.Session.DeviceID, "reason", reason)
+	_ = c.writePacket(kickedPacket(reason), nil)
+	c.Close()
+}
+
+// kickedPacket 构造携带下线原因的 KICKED 通知
+func kickedPacket(reason string) *connectpb.Packet {
+	data, _ := proto.Marshal(&connectpb.KickedNotice{Reason: reason})
+	return &connectpb.Packet{Command: connectpb.Command_KICKED, Data: data}
+}
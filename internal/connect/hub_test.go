@@ -0,0 +1,118 @@
+package connect
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"im-server/pkg/protocol/pb/connectpb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// discardTransport 丢弃所有写入，用于基准测试
+type discardTransport struct{}
+
+func (discardTransport) Write([]byte) error                { return nil }
+func (discardTransport) Close() error                      { return nil }
+func (discardTransport) RemoteAddr() net.Addr              { return &net.TCPAddr{} }
+func (discardTransport) SetReadDeadline(t time.Time) error { return nil }
+func (discardTransport) ReadMessage() ([]byte, error)      { return nil, net.ErrClosed }
+
+func TestUserIndexConsistency(t *testing.T) {
+	newTestConn(t) // 注册 Offline 的 mock，被替换的旧连接关闭时会调用
+	c1 := &Conn{Session: &Session{UserID: 1, DeviceID: 10}, Transport: discardTransport{}}
+	c2 := &Conn{Session: &Session{UserID: 1, DeviceID: 11}, Transport: discardTransport{}}
+	SetConnection(10, c1)
+	SetConnection(11, c2)
+	assert.ElementsMatch(t, []*Conn{c1, c2}, userConnections(1))
+
+	// 同一设备重连：新连接替换旧连接，旧连接被踢下线后关闭不影响新连接
+	c3 := &Conn{Session: &Session{UserID: 1, DeviceID: 10}, Transport: discardTransport{}}
+	SetConnection(10, c3)
+	assert.Same(t, c3, GetConnection(10))
+	assert.ElementsMatch(t, []*Conn{c3, c2}, userConnections(1))
+
+	// 设备切换登录用户时从旧用户索引中移除
+	c4 := &Conn{Session: &Session{UserID: 2, DeviceID: 11}, Transport: discardTransport{}}
+	SetConnection(11, c4)
+	assert.ElementsMatch(t, []*Conn{c3}, userConnections(1))
+	assert.ElementsMatch(t, []*Conn{c4}, userConnections(2))
+
+	DeleteConnection(10)
+	removeConnection(11, c4)
+	assert.Empty(t, userConnections(1))
+	assert.Empty(t, userConnections(2))
+	shard := userShardOf(1)
+	shard.mu.RLock()
+	assert.NotContains(t, shard.devices, uint64(1))
+	shard.mu.RUnlock()
+}
+
+// setupConnections 注册 n 个连接，每个用户 2 台设备，返回清理函数
+func setupConnections(n int) func() {
+	for i := 0; i < n; i++ {
+		deviceID := uint64(i + 1)
+		SetConnection(deviceID, &Conn{Session: &Session{UserID: deviceID/2 + 1, DeviceID: deviceID}, Transport: discardTransport{}})
+	}
+	return func() {
+		for i := 0; i < n; i++ {
+			DeleteConnection(uint64(i + 1))
+		}
+	}
+}
+
+// BenchmarkDeliverToUser 基于用户索引的投递，耗时与总连接数无关
+func BenchmarkDeliverToUser(b *testing.B) {
+	for _, n := range []int{1000, 10000, 50000} {
+		b.Run(fmt.Sprintf("conns=%d", n), func(b *testing.B) {
+			defer setupConnections(n)()
+			pkt := &connectpb.Packet{Command: connectpb.Command_READ_RECEIPT, Data: []byte("receipt")}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				DeliverToUser(uint64(i%(n/2))+1, pkt)
+			}
+		})
+	}
+}
+
+// BenchmarkRangeConnections 作为对照：遍历全部连接查找用户设备的开销
+func BenchmarkRangeConnections(b *testing.B) {
+	for _, n := range []int{1000, 10000, 50000} {
+		b.Run(fmt.Sprintf("conns=%d", n), func(b *testing.B) {
+			defer setupConnections(n)()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				userID := uint64(i%(n/2)) + 1
+				ConnectManager.Range(func(key, value any) bool {
+					_ = value.(*Conn).Session.UserID == userID
+					return true
+				})
+			}
+		})
+	}
+}
+
+func TestSetConnectionKicksReplacedConn(t *testing.T) {
+	old, tr := newTestConn(t)
+	SetConnection(old.Session.DeviceID, old)
+
+	conn := &Conn{Session: &Session{UserID: old.Session.UserID, DeviceID: old.Session.DeviceID}, Transport: discardTransport{}}
+	SetConnection(conn.Session.DeviceID, conn)
+	defer DeleteConnection(conn.Session.DeviceID)
+
+	writes, closed := tr.state()
+	assert.Equal(t, 1, writes)
+	assert.True(t, closed)
+	assert.Same(t, conn, GetConnection(conn.Session.DeviceID))
+
+	var pkt connectpb.Packet
+	require.NoError(t, proto.Unmarshal(tr.writes[0], &pkt))
+	assert.Equal(t, connectpb.Command_KICKED, pkt.Command)
+	var notice connectpb.KickedNotice
+	require.NoError(t, proto.Unmarshal(pkt.Data, &notice))
+	assert.Equal(t, reasonDeviceReconnected, notice.Reason)
+}
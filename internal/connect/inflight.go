@@ -0,0 +1,152 @@
+package connect
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"im-server/pkg/config"
+)
+
+const (
+	defaultAckTimeout     = 5 * time.Second
+	defaultAckMaxRetries  = 3
+	defaultInflightWindow = 256
+)
+
+// ackTimeout 推送后等待客户端 DELIVER_ACK 的超时时间
+func ackTimeout() time.Duration {
+	if d, err := time.ParseDuration(config.Config.Services.Connect.AckTimeout); err == nil && d > 0 {
+		return d
+	}
+	return defaultAckTimeout
+}
+
+// ackMaxRetries 未确认消息的最大重传次数
+func ackMaxRetries() int {
+	if n := config.Config.Services.Connect.AckMaxRetries; n > 0 {
+		return n
+	}
+	return defaultAckMaxRetries
+}
+
+// inflightWindow 单设备允许同时存在的未确认消息数
+func inflightWindow() int {
+	if n := config.Config.Services.Connect.InflightWindow; n > 0 {
+		return n
+	}
+	return defaultInflightWindow
+}
+
+// inflightKey 以 (conversation_id, seq) 唯一标识一条待确认的推送
+type inflightKey struct {
+	conversationID string
+	seq            int64
+}
+
+// inflightEntry 一条待确认推送的重传状态
+type inflightEntry struct {
+	buf     []byte      // 已序列化的 Packet，重传时原样写出
+	retries int         // 已重传次数
+	timer   *time.Timer // 重传定时器
+}
+
+// Inflight 是单个连接上已推送但尚未被客户端确认的消息窗口。
+// 超时未确认的消息会被重传，超过最大重传次数或窗口已满时关闭连接，
+// 客户端重连后通过离线同步补齐缺失的消息，从而保证对在线设备至少一次送达。
+type Inflight struct {
+	mu      sync.Mutex
+	conn    *Conn
+	entries map[inflightKey]*inflightEntry
+	closed  bool
+}
+
+func newInflight(conn *Conn) *Inflight {
+	return &Inflight{
+		conn:    conn,
+		entries: make(map[inflightKey]*inflightEntry),
+	}
+}
+
+// Track 登记一条即将推送的消息并启动重传定时器。
+// 返回 false 表示窗口已满或连接已关闭，调用方不应再写出该消息。
+func (f *Inflight) Track(conversationID string, seq int64, buf []byte) bool {
+	key := inflightKey{conversationID: conversationID, seq: seq}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return false
+	}
+	if e, ok := f.entries[key]; ok {
+		// 同一条消息重复投递（如 Kafka 重放），沿用原有的重传状态
+		e.buf = buf
+		return true
+	}
+	if len(f.entries) >= inflightWindow() {
+		return false
+	}
+	e := &inflightEntry{buf: buf}
+	e.timer = time.AfterFunc(ackTimeout(), func() { f.retransmit(key) })
+	f.entries[key] = e
+	return true
+}
+
+// Ack 处理客户端的送达确认，移除对应的待确认消息
+func (f *Inflight) Ack(conversationID string, seq int64) bool {
+	key := inflightKey{conversationID: conversationID, seq: seq}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	e, ok := f.entries[key]
+	if !ok {
+		return false
+	}
+	e.timer.Stop()
+	delete(f.entries, key)
+	return true
+}
+
+// Len 返回当前待确认的消息数
+func (f *Inflight) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.entries)
+}
+
+// Stop 停止所有重传定时器并清空窗口，连接关闭时调用
+func (f *Inflight) Stop() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	for key, e := range f.entries {
+		e.timer.Stop()
+		delete(f.entries, key)
+	}
+}
+
+// retransmit 重传超时未确认的消息，超过最大重传次数则放弃并关闭连接
+func (f *Inflight) retransmit(key inflightKey) {
+	f.mu.Lock()
+	e, ok := f.entries[key]
+	if !ok || f.closed {
+		f.mu.Unlock()
+		return
+	}
+	if e.retries >= ackMaxRetries() {
+		delete(f.entries, key)
+		f.mu.Unlock()
+		slog.Warn("deliver ack timeout, fallback to offline sync", "deviceID", f.conn.Session.DeviceID, "conversation", key.conversationID, "seq", key.seq, "retries", e.retries)
+		f.conn.Close()
+		return
+	}
+	e.retries++
+	retry, buf := e.retries, e.buf
+	e.timer.Reset(ackTimeout())
+	f.mu.Unlock()
+
+	slog.Info("retransmit message", "deviceID", f.conn.Session.DeviceID, "conversation", key.conversationID, "seq", key.seq, "retry", retry)
+	if err := f.conn.Write(buf); err != nil {
+		slog.Error("retransmit message", "err", err, "deviceID", f.conn.Session.DeviceID)
+	}
+}
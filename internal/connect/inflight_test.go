@@ -0,0 +1,114 @@
+package connect
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/mocks"
+	"im-server/pkg/rpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTransport 记录写出的数据，用于验证推送与重传
+type fakeTransport struct {
+	mu     sync.Mutex
+	writes [][]byte
+	closed bool
+}
+
+func (t *fakeTransport) Write(buf []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.writes = append(t.writes, buf)
+	return nil
+}
+
+func (t *fakeTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	return nil
+}
+
+func (t *fakeTransport) RemoteAddr() net.Addr {
+	return &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 10000}
+}
+
+func (t *fakeTransport) SetReadDeadline(time.Time) error { return nil }
+
+func (t *fakeTransport) ReadMessage() ([]byte, error) { return nil, net.ErrClosed }
+
+func (t *fakeTransport) state() (int, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.writes), t.closed
+}
+
+func newTestConn(t *testing.T) (*Conn, *fakeTransport) {
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockDeviceIntServiceClient(ctrl)
+	mockClient.EXPECT().Offline(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	rpc.SetDeviceIntServiceClient(mockClient)
+
+	tr := &fakeTransport{}
+	conn := &Conn{Session: &Session{UserID: 1, DeviceID: 100}, Transport: tr}
+	conn.Inflight = newInflight(conn)
+	return conn, tr
+}
+
+func TestInflightAckStopsRetransmit(t *testing.T) {
+	config.Config.Services.Connect.AckTimeout = "20ms"
+	config.Config.Services.Connect.AckMaxRetries = 3
+	defer func() { config.Config.Services.Connect.AckTimeout = "" }()
+
+	conn, tr := newTestConn(t)
+	assert.True(t, conn.Inflight.Track("c_1_2", 1, []byte("m1")))
+	assert.True(t, conn.Inflight.Ack("c_1_2", 1))
+	assert.False(t, conn.Inflight.Ack("c_1_2", 1))
+
+	time.Sleep(60 * time.Millisecond)
+	writes, closed := tr.state()
+	assert.Equal(t, 0, writes)
+	assert.False(t, closed)
+	assert.Equal(t, 0, conn.Inflight.Len())
+}
+
+func TestInflightRetransmitThenClose(t *testing.T) {
+	config.Config.Services.Connect.AckTimeout = "10ms"
+	config.Config.Services.Connect.AckMaxRetries = 2
+	defer func() {
+		config.Config.Services.Connect.AckTimeout = ""
+		config.Config.Services.Connect.AckMaxRetries = 0
+	}()
+
+	conn, tr := newTestConn(t)
+	assert.True(t, conn.Inflight.Track("c_1_2", 1, []byte("m1")))
+
+	assert.Eventually(t, func() bool {
+		_, closed := tr.state()
+		return closed
+	}, time.Second, 5*time.Millisecond)
+
+	writes, _ := tr.state()
+	assert.Equal(t, 2, writes)
+	assert.Equal(t, 0, conn.Inflight.Len())
+	assert.False(t, conn.Inflight.Track("c_1_2", 2, []byte("m2")))
+}
+
+func TestInflightWindowFull(t *testing.T) {
+	config.Config.Services.Connect.InflightWindow = 2
+	defer func() { config.Config.Services.Connect.InflightWindow = 0 }()
+
+	conn, _ := newTestConn(t)
+	defer conn.Inflight.Stop()
+	assert.True(t, conn.Inflight.Track("c_1_2", 1, []byte("m1")))
+	assert.True(t, conn.Inflight.Track("c_1_2", 2, []byte("m2")))
+	// 重复投递同一条消息不占用新的窗口
+	assert.True(t, conn.Inflight.Track("c_1_2", 2, []byte("m2")))
+	assert.False(t, conn.Inflight.Track("c_1_2", 3, []byte("m3")))
+}
@@ -0,0 +1,156 @@
+package connect
+
+import (
+	"container/list"
+	"log/slog"
+	"sync"
+
+	"im-server/pkg/protocol/pb/connectpb"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Room 是本节点上某个房间的订阅者集合，连接通过 Session.Element 记录自身在链表中的位置以便 O(1) 退订
+type Room struct {
+	ID    uint64
+	mu    sync.RWMutex
+	conns *list.List
+}
+
+// roomRegistry 管理本节点的全部房间，房间在首个订阅者加入时创建、最后一个订阅者离开时删除
+type roomRegistry struct {
+	mu    sync.Mutex
+	rooms map[uint64]*Room
+}
+
+var RoomManager = &roomRegistry{rooms: make(map[uint64]*Room)}
+
+// RoomTopic 房间广播主题，每个 connect 节点以独立的消费组消费，保证都能收到全部房间事件
+const RoomTopic = "room.broadcast"
+
+// RoomEvent 房间广播事件的 Kafka 负载。房间消息不进入会话模型，也不做持久化，
+// Data 为对应指令的 protobuf 编码
+type RoomEvent struct {
+	RoomID  uint64            `json:"room_id"`
+	Command connectpb.Command `json:"command"`
+	Data    []byte            `json:"data"`
+}
+
+// SubscribeRoom 将连接加入房间，一个连接同一时间只订阅一个房间，已订阅其他房间时先退订。
+// 连接已关闭时不再订阅并返回 false，避免关闭后残留在房间中
+func SubscribeRoom(roomID uint64, conn *Conn) bool {
+	RoomManager.mu.Lock()
+	defer RoomManager.mu.Unlock()
+	if conn.roomClosed {
+		return false
+	}
+	unsubscribeRoomLocked(conn)
+	room, ok := RoomManager.rooms[roomID]
+	if !ok {
+		room = &Room{ID: roomID, conns: list.New()}
+		RoomManager.rooms[roomID] = room
+	}
+	room.mu.Lock()
+	conn.Session.Element = room.conns.PushBack(conn)
+	conn.Session.RoomID = roomID
+	room.mu.Unlock()
+	return true
+}
+
+// UnsubscribeRoom 将连接从其订阅的房间中移除，房间为空时一并删除
+func UnsubscribeRoom(conn *Conn) {
+	RoomManager.mu.Lock()
+	defer RoomManager.mu.Unlock()
+	unsubscribeRoomLocked(conn)
+}
+
+// closeRoom 在连接关闭时退订房间，并禁止之后再订阅
+func closeRoom(conn *Conn) {
+	RoomManager.mu.Lock()
+	defer RoomManager.mu.Unlock()
+	conn.roomClosed = true
+	unsubscribeRoomLocked(conn)
+}
+
+// unsubscribeRoomLocked 退订连接当前的房间，调用方需持有 RoomManager.mu
+func unsubscribeRoomLocked(conn *Conn) {
+	if conn.Session.RoomID == 0 {
+		return
+	}
+	if room, ok := RoomManager.rooms[conn.Session.RoomID]; ok {
+		room.mu.Lock()
+		room.conns.Remove(conn.Session.Element)
+		empty := room.conns.Len() == 0
+		room.mu.Unlock()
+		if empty {
+			delete(RoomManager.rooms, room.ID)
+		}
+	}
+	conn.Session.RoomID = 0
+	conn.Session.Element = nil
+}
+
+// RoomSubscribers 返回本节点上房间的订阅连接数
+func RoomSubscribers(roomID uint64) int {
+	RoomManager.mu.Lock()
+	room, ok := RoomManager.rooms[roomID]
+	RoomManager.mu.Unlock()
+	if !ok {
+		return 0
+	}
+	room.mu.RLock()
+	defer room.mu.RUnlock()
+	return room.conns.Len()
+}
+
+// RoomBroadcast 向本节点上订阅了房间的所有连接推送一个 Packet，返回成功写出的连接数
+func RoomBroadcast(roomID uint64, pkt *connectpb.Packet) int {
+	RoomManager.mu.Lock()
+	room, ok := RoomManager.rooms[roomID]
+	RoomManager.mu.Unlock()
+	if !ok {
+		return 0
+	}
+	ep := newEncodedPacket(pkt)
+
+	// 先复制订阅者列表再写出，写失败时 Close 会退订房间，不能持锁写
+	room.mu.RLock()
+	conns := make([]*Conn, 0, room.conns.Len())
+	for e := room.conns.Front(); e != nil; e = e.Next() {
+		conns = append(conns, e.Value.(*Conn))
+	}
+	room.mu.RUnlock()
+
+	count := 0
+	for _, conn := range conns {
+		buf, err := ep.bytesFor(conn)
+		if err == nil {
+			err = conn.Write(buf)
+		}
+		if err == nil {
+			count++
+		} else {
+			slog.Error("write room packet", "err", err, "roomID", roomID, "deviceID", conn.Session.DeviceID)
+		}
+	}
+	return count
+}
+
+// SubscribeRoom 处理客户端的房间订阅请求，room_id 为 0 表示退订当前房间
+func (c *Conn) SubscribeRoom(packet *connectpb.Packet) {
+	var input connectpb.SubscribeRoomInput
+	err := proto.Unmarshal(packet.Data, &input)
+	if err != nil {
+		slog.Error("unmarshal error", "error", err)
+		c.SendError(packet, connectpb.ErrorCode_ERR_INVALID_ARGUMENT, "invalid data")
+		return
+	}
+
+	if input.RoomId == 0 {
+		UnsubscribeRoom(c)
+	} else if !SubscribeRoom(input.RoomId, c) {
+		c.SendError(packet, connectpb.ErrorCode_ERR_UNAVAILABLE, "connection closed")
+		return
+	}
+	c.Send(packet, nil, nil)
+}
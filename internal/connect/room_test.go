@@ -0,0 +1,93 @@
+package connect
+
+import (
+	"sync"
+	"testing"
+
+	"im-server/pkg/protocol/pb/connectpb"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRoomSubscribeAndBroadcast(t *testing.T) {
+	c1, t1 := newTestConn(t)
+	c2, t2 := newTestConn(t)
+	c2.Session.DeviceID = 101
+
+	SubscribeRoom(1, c1)
+	SubscribeRoom(1, c2)
+	assert.Equal(t, 2, RoomSubscribers(1))
+
+	n := RoomBroadcast(1, &connectpb.Packet{Command: connectpb.Command_MESSAGE})
+	assert.Equal(t, 2, n)
+	w1, _ := t1.state()
+	w2, _ := t2.state()
+	assert.Equal(t, 1, w1)
+	assert.Equal(t, 1, w2)
+
+	// 切换房间会先退订原房间
+	SubscribeRoom(2, c2)
+	assert.Equal(t, 1, RoomSubscribers(1))
+	assert.Equal(t, 1, RoomSubscribers(2))
+	assert.Equal(t, uint64(2), c2.Session.RoomID)
+
+	// 关闭连接时清理房间，空房间被删除
+	c1.Close()
+	c2.Close()
+	assert.Equal(t, 0, RoomSubscribers(1))
+	assert.Equal(t, 0, RoomSubscribers(2))
+	assert.Equal(t, 0, RoomBroadcast(1, &connectpb.Packet{Command: connectpb.Command_MESSAGE}))
+	RoomManager.mu.Lock()
+	assert.Empty(t, RoomManager.rooms)
+	RoomManager.mu.Unlock()
+}
+
+func TestSubscribeRoomCommand(t *testing.T) {
+	conn, tr := newTestConn(t)
+	defer conn.Close()
+
+	data, _ := proto.Marshal(&connectpb.SubscribeRoomInput{RoomId: 9})
+	conn.SubscribeRoom(&connectpb.Packet{Command: connectpb.Command_SUBSCRIBE_ROOM, RequestId: 1, Data: data})
+	assert.Equal(t, 1, RoomSubscribers(9))
+
+	data, _ = proto.Marshal(&connectpb.SubscribeRoomInput{RoomId: 0})
+	conn.SubscribeRoom(&connectpb.Packet{Command: connectpb.Command_SUBSCRIBE_ROOM, RequestId: 2, Data: data})
+	assert.Equal(t, 0, RoomSubscribers(9))
+	assert.Equal(t, uint64(0), conn.Session.RoomID)
+
+	writes, _ := tr.state()
+	assert.Equal(t, 2, writes)
+}
+
+func TestSubscribeRoomAfterClose(t *testing.T) {
+	conn, tr := newTestConn(t)
+	conn.Close()
+
+	assert.False(t, SubscribeRoom(3, conn))
+	assert.Equal(t, 0, RoomSubscribers(3))
+
+	data, _ := proto.Marshal(&connectpb.SubscribeRoomInput{RoomId: 3})
+	conn.SubscribeRoom(&connectpb.Packet{Command: connectpb.Command_SUBSCRIBE_ROOM, RequestId: 1, Data: data})
+	assert.Equal(t, uint32(connectpb.ErrorCode_ERR_UNAVAILABLE), lastPacket(t, tr).Code)
+	assert.Equal(t, 0, RoomSubscribers(3))
+}
+
+func TestSubscribeRoomRacesClose(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		conn, _ := newTestConn(t)
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			SubscribeRoom(4, conn)
+		}()
+		go func() {
+			defer wg.Done()
+			conn.Close()
+		}()
+		wg.Wait()
+		// 无论先后，关闭后连接都不能留在房间中
+		assert.Equal(t, 0, RoomSubscribers(4))
+	}
+}
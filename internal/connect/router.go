@@ -0,0 +1,147 @@
+package connect
+
+import (
+	"context"
+	"log/slog"
+
+	"im-server/pkg/config"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/devicepb"
+	"im-server/pkg/rpc"
+)
+
+// RouteToUsers 将 Packet 投递到用户的全部在线设备。
+// 按设备登录时记录的 conn_addr 分组：本节点的设备直接写出，其他节点的设备通过其 ConnectIntService 转发，
+// 保证每个设备只由持有其连接的节点投递。返回成功写出的设备数
+func RouteToUsers(ctx context.Context, userIDs []uint64, pkt *connectpb.Packet) int {
+	reply, err := rpc.GetDeviceIntServiceClient().ListOnlineDevices(ctx, &devicepb.ListOnlineDevicesRequest{UserIds: userIDs})
+	if err != nil {
+		// 设备服务不可用时退化为仅投递本节点，其余设备由离线同步补齐
+		slog.Error("list online devices, fallback to local delivery", "err", err)
+		count := 0
+		for _, uid := range userIDs {
+			count += DeliverToUser(uid, pkt)
+		}
+		return count
+	}
+
+	nodes := make(map[string][]uint64)
+	for _, d := range reply.Devices {
+		if d.ConnAddr == "" {
+			slog.Warn("online device without conn addr, skip", "deviceID", d.DeviceId, "userID", d.UserId)
+			continue
+		}
+		nodes[d.ConnAddr] = append(nodes[d.ConnAddr], d.DeviceId)
+	}
+
+	count := 0
+	for addr, deviceIDs := range nodes {
+		count += routeToDevices(ctx, addr, deviceIDs, pkt)
+	}
+	return count
+}
+
+// RouteToDevice 将 Packet 投递到用户的某个设备。
+// 设备在本节点时直接写出，否则按在线信息定位其所在节点后转发
+func RouteToDevice(ctx context.Context, userID, deviceID uint64, pkt *connectpb.Packet) bool {
+	addr, ok := deviceNode(ctx, userID, deviceID)
+	if !ok {
+		return false
+	}
+	return routeToDevices(ctx, addr, []uint64{deviceID}, pkt) > 0
+}
+
+// RouteKick 将用户的某个设备踢下线：下发携带原因的 KICKED 通知后关闭连接。
+// 设备在本节点时直接踢下线，否则按在线信息定位其所在节点后转发
+func RouteKick(ctx context.Context, userID, deviceID uint64, reason string) bool {
+	addr, ok := deviceNode(ctx, userID, deviceID)
+	if !ok {
+		return false
+	}
+	return kickOnNode(ctx, addr, []uint64{deviceID}, reason) > 0
+}
+
+// deviceNode 返回设备连接所在节点的地址，设备不在线时返回 false
+func deviceNode(ctx context.Context, userID, deviceID uint64) (string, bool) {
+	local := config.Config.Services.Connect.LocalAddr
+	if GetConnection(deviceID) != nil {
+		return local, true
+	}
+	reply, err := rpc.GetDeviceIntServiceClient().ListOnlineDevices(ctx, &devicepb.ListOnlineDevicesRequest{UserIds: []uint64{userID}})
+	if err != nil {
+		slog.Error("list online devices", "err", err, "userID", userID)
+		return "", false
+	}
+	for _, d := range reply.Devices {
+		if d.DeviceId != deviceID || d.ConnAddr == "" || d.ConnAddr == local {
+			continue
+		}
+		return d.ConnAddr, true
+	}
+	slog.Info("device offline, skip", "deviceID", deviceID)
+	return "", false
+}
+
+// routeToDevices 将 Packet 投递到某个节点上的设备：本节点直接写出，其他节点通过其 ConnectIntService 转发
+func routeToDevices(ctx context.Context, addr string, deviceIDs []uint64, pkt *connectpb.Packet) int {
+	if addr == config.Config.Services.Connect.LocalAddr {
+		return deliverLocal(deviceIDs, pkt)
+	}
+	r, err := rpc.GetConnectIntServiceClient(addr).DeliverToDevices(ctx, &connectpb.DeliverToDevicesRequest{
+		DeviceIds: deviceIDs,
+		Packet:    pkt,
+	})
+	if err != nil {
+		slog.Error("forward packet to connect node", "err", err, "node", addr, "devices", deviceIDs)
+		return 0
+	}
+	return int(r.Delivered)
+}
+
+// kickOnNode 将某个节点上的设备踢下线：本节点直接踢下线，其他节点通过其 ConnectIntService 转发
+func kickOnNode(ctx context.Context, addr string, deviceIDs []uint64, reason string) int {
+	if addr == config.Config.Services.Connect.LocalAddr {
+		return kickLocal(deviceIDs, reason)
+	}
+	r, err := rpc.GetConnectIntServiceClient(addr).DeliverToDevices(ctx, &connectpb.DeliverToDevicesRequest{
+		DeviceIds:  deviceIDs,
+		CloseAfter: true,
+		KickReason: reason,
+	})
+	if err != nil {
+		slog.Error("forward kick to connect node", "err", err, "node", addr, "devices", deviceIDs)
+		return 0
+	}
+	return int(r.Delivered)
+}
+
+// deliverLocal 向本节点上的设备写出 Packet，返回成功写出的设备数
+func deliverLocal(deviceIDs []uint64, pkt *connectpb.Packet) int {
+	count := 0
+	for _, deviceID := range deviceIDs {
+		if DeliverToDevice(deviceID, pkt) {
+			count++
+		}
+	}
+	return count
+}
+
+// kickLocal 将本节点上的设备踢下线，返回在线并被踢下线的设备数
+func kickLocal(deviceIDs []uint64, reason string) int {
+	count := 0
+	for _, deviceID := range deviceIDs {
+		if kickDevice(deviceID, reason) {
+			count++
+		}
+	}
+	return count
+}
+
+// KickSuperseded 将被新登录顶替的设备踢下线：向其所在节点下发 KICKED 通知并关闭连接
+func KickSuperseded(ctx context.Context, device *devicepb.OnlineDevice, reason string) {
+	if device.GetConnAddr() == "" {
+		return
+	}
+	n := kickOnNode(ctx, device.ConnAddr, []uint64{device.DeviceId}, reason)
+	slog.Info("kick superseded device", "userID", device.UserId, "deviceID", device.DeviceId, "node", device.ConnAddr, "reason", reason, "kicked", n > 0)
+}
@@ -0,0 +1,61 @@
+package connect
+
+import (
+	"context"
+	"testing"
+
+	"im-server/pkg/config"
+	"im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/devicepb"
+	"im-server/pkg/rpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// fakeConnectInt 记录被转发到远端节点的请求
+type fakeConnectInt struct {
+	connectpb.ConnectIntServiceClient
+	reqs []*connectpb.DeliverToDevicesRequest
+}
+
+func (f *fakeConnectInt) DeliverToDevices(ctx context.Context, in *connectpb.DeliverToDevicesRequest, opts ...grpc.CallOption) (*connectpb.DeliverToDevicesReply, error) {
+	f.reqs = append(f.reqs, in)
+	return &connectpb.DeliverToDevicesReply{Delivered: uint32(len(in.DeviceIds))}, nil
+}
+
+func TestRouteToUsers(t *testing.T) {
+	config.Config.Services.Connect.LocalAddr = "node-a:50055"
+
+	ctrl := gomock.NewController(t)
+	mockDevice := mocks.NewMockDeviceIntServiceClient(ctrl)
+	mockDevice.EXPECT().Offline(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockDevice.EXPECT().ListOnlineDevices(gomock.Any(), gomock.Any()).Return(&devicepb.ListOnlineDevicesReply{
+		Devices: []*devicepb.OnlineDevice{
+			{DeviceId: 100, UserId: 1, ConnAddr: "node-a:50055"},
+			{DeviceId: 200, UserId: 2, ConnAddr: "node-b:50055"},
+			{DeviceId: 201, UserId: 2, ConnAddr: "node-b:50055"},
+		},
+	}, nil)
+	rpc.SetDeviceIntServiceClient(mockDevice)
+
+	remote := &fakeConnectInt{}
+	rpc.SetConnectIntServiceClient("node-b:50055", remote)
+
+	tr := &fakeTransport{}
+	local := &Conn{Session: &Session{UserID: 1, DeviceID: 100}, Transport: tr}
+	SetConnection(100, local)
+	defer DeleteConnection(100)
+
+	n := RouteToUsers(context.Background(), []uint64{1, 2}, &connectpb.Packet{Command: connectpb.Command_READ_RECEIPT})
+	assert.Equal(t, 3, n)
+
+	writes, _ := tr.state()
+	assert.Equal(t, 1, writes)
+	if assert.Len(t, remote.reqs, 1) {
+		assert.ElementsMatch(t, []uint64{200, 201}, remote.reqs[0].DeviceIds)
+		assert.Equal(t, connectpb.Command_READ_RECEIPT, remote.reqs[0].Packet.Command)
+	}
+}
@@ -0,0 +1,96 @@
+package connect
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/messagepb"
+	"im-server/pkg/rpc"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultSignalRateLimit = 5
+
+// signalRateLimit 单连接每秒允许发送的瞬时信号数，同时作为突发上限
+func signalRateLimit() int {
+	if n := config.Config.Services.Connect.SignalRateLimit; n > 0 {
+		return n
+	}
+	return defaultSignalRateLimit
+}
+
+// rateLimiter 令牌桶限流器。仅在连接的读协程中使用，无需加锁
+type rateLimiter struct {
+	tokens float64
+	last   time.Time
+}
+
+// allow 按每秒 rate 个令牌补充令牌桶，有剩余令牌时消耗一个并返回 true
+func (l *rateLimiter) allow(rate int, now time.Time) bool {
+	if l.last.IsZero() {
+		l.tokens = float64(rate)
+	} else {
+		l.tokens = min(float64(rate), l.tokens+now.Sub(l.last).Seconds()*float64(rate))
+	}
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// Signal 处理客户端的瞬时信号（如正在输入）：校验好友关系或群成员身份后，
+// 经跨节点路由直接推送给接收方的在线设备，不做任何存储，离线设备不会收到
+func (c *Conn) Signal(packet *connectpb.Packet) {
+	var input connectpb.SignalInput
+	err := proto.Unmarshal(packet.Data, &input)
+	if err != nil {
+		slog.Error("unmarshal error", "error", err)
+		c.SendError(packet, connectpb.ErrorCode_ERR_INVALID_ARGUMENT, "invalid data")
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.SendError(packet, connectpb.ErrorCode_ERR_INVALID_ARGUMENT, err.Error())
+		return
+	}
+	now := time.Now()
+	if !c.signalLimiter.allow(signalRateLimit(), now) {
+		c.SendError(packet, connectpb.ErrorCode_ERR_RESOURCE_EXHAUSTED, "too many signals")
+		return
+	}
+
+	ctx := context.TODO()
+	reply, err := rpc.GetMessageIntServiceClient().ResolveSignalTargets(ctx, &messagepb.ResolveSignalTargetsRequest{
+		UserId:      c.Session.UserID,
+		RecipientId: input.RecipientId,
+		GroupId:     input.GroupId,
+	})
+	if err != nil {
+		c.Send(packet, nil, err)
+		return
+	}
+
+	data, err := proto.Marshal(&connectpb.SignalEvent{
+		SenderId:    c.Session.UserID,
+		RecipientId: input.RecipientId,
+		GroupId:     input.GroupId,
+		Type:        input.Type,
+		Payload:     input.Payload,
+		SendTime:    now.UnixMilli(),
+	})
+	if err != nil {
+		c.Send(packet, nil, err)
+		return
+	}
+	n := RouteToUsers(ctx, reply.Recipients, &connectpb.Packet{
+		Command: connectpb.Command_SIGNAL,
+		Data:    data,
+	})
+	slog.Debug("relay signal", "userID", c.Session.UserID, "type", input.Type, "recipients", len(reply.Recipients), "devices", n)
+	c.Send(packet, nil, nil)
+}
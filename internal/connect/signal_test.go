@@ -0,0 +1,83 @@
+package connect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/devicepb"
+	"im-server/pkg/protocol/pb/messagepb"
+	"im-server/pkg/rpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeMessageInt 返回固定的信号接收方
+type fakeMessageInt struct {
+	messagepb.MessageIntServiceClient
+	recipients []uint64
+}
+
+func (f *fakeMessageInt) ResolveSignalTargets(ctx context.Context, in *messagepb.ResolveSignalTargetsRequest, opts ...grpc.CallOption) (*messagepb.ResolveSignalTargetsReply, error) {
+	return &messagepb.ResolveSignalTargetsReply{Recipients: f.recipients}, nil
+}
+
+func TestRateLimiter(t *testing.T) {
+	var l rateLimiter
+	now := time.Now()
+	assert.True(t, l.allow(2, now))
+	assert.True(t, l.allow(2, now))
+	assert.False(t, l.allow(2, now))
+	assert.True(t, l.allow(2, now.Add(500*time.Millisecond)))
+	assert.False(t, l.allow(2, now.Add(500*time.Millisecond)))
+}
+
+func TestSignalRelay(t *testing.T) {
+	config.Config.Services.Connect.LocalAddr = "127.0.0.1:8080"
+	config.Config.Services.Connect.SignalRateLimit = 1
+	defer func() { config.Config.Services.Connect.SignalRateLimit = 0 }()
+
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockDeviceIntServiceClient(ctrl)
+	mockClient.EXPECT().ListOnlineDevices(gomock.Any(), gomock.Any()).Return(&devicepb.ListOnlineDevicesReply{
+		Devices: []*devicepb.OnlineDevice{{DeviceId: 300, UserId: 2, ConnAddr: "127.0.0.1:8080"}},
+	}, nil).Times(1)
+	rpc.SetDeviceIntServiceClient(mockClient)
+	rpc.SetMessageIntServiceClient(&fakeMessageInt{recipients: []uint64{2}})
+
+	senderTr, recipientTr := &fakeTransport{}, &fakeTransport{}
+	sender := &Conn{Session: &Session{UserID: 1, DeviceID: 100}, Transport: senderTr}
+	recipient := &Conn{Session: &Session{UserID: 2, DeviceID: 300}, Transport: recipientTr}
+	SetConnection(recipient.Session.DeviceID, recipient)
+	defer DeleteConnection(recipient.Session.DeviceID)
+
+	data, err := proto.Marshal(&connectpb.SignalInput{RecipientId: 2, Type: "typing"})
+	require.NoError(t, err)
+	buf, err := proto.Marshal(&connectpb.Packet{Command: connectpb.Command_SIGNAL, RequestId: 9, Data: data})
+	require.NoError(t, err)
+
+	sender.HandleMessage(buf)
+	reply := lastPacket(t, senderTr)
+	assert.Equal(t, int64(9), reply.RequestId)
+	assert.Zero(t, reply.Code)
+
+	pkt := lastPacket(t, recipientTr)
+	assert.Equal(t, connectpb.Command_SIGNAL, pkt.Command)
+	var event connectpb.SignalEvent
+	require.NoError(t, proto.Unmarshal(pkt.Data, &event))
+	assert.Equal(t, uint64(1), event.SenderId)
+	assert.Equal(t, "typing", event.Type)
+
+	// 超出限流的信号直接拒绝，不再转发
+	sender.HandleMessage(buf)
+	assert.Equal(t, uint32(connectpb.ErrorCode_ERR_RESOURCE_EXHAUSTED), lastPacket(t, senderTr).Code)
+	writes, _ := recipientTr.state()
+	assert.Equal(t, 1, writes)
+}
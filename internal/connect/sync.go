@@ -0,0 +1,47 @@
+package connect
+
+import (
+	"context"
+	"log/slog"
+
+	"im-server/pkg/config"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/messagepb"
+	"im-server/pkg/rpc"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultSyncPageSize = 50
+	maxSyncPageSize     = 100 // 与 SyncMessagesRequest.limit 的校验上限一致
+)
+
+// syncPageSize SYNC 每批下发的消息条数
+func syncPageSize() int {
+	n := config.Config.Services.Connect.SyncPageSize
+	if n <= 0 {
+		return defaultSyncPageSize
+	}
+	return min(n, maxSyncPageSize)
+}
+
+// Sync 处理客户端的离线同步请求，每个请求只从消息服务拉取并回复一批消息：
+// 同步在读协程中进行，分批由客户端以回复中的游标驱动，避免大量追赶阻塞心跳等上行数据包、写满写队列
+func (c *Conn) Sync(packet *connectpb.Packet) {
+	var input connectpb.SyncInput
+	err := proto.Unmarshal(packet.Data, &input)
+	if err != nil {
+		slog.Error("unmarshal error", "error", err)
+		c.SendError(packet, connectpb.ErrorCode_ERR_INVALID_ARGUMENT, "invalid data")
+		return
+	}
+
+	ctx := rpc.WithToken(context.TODO(), c.Session.Token)
+	reply, err := rpc.GetMessageExtServiceClient().SyncMessages(ctx, &messagepb.SyncMessagesRequest{
+		Cursors:     input.Cursors,
+		InboxCursor: input.InboxCursor,
+		Limit:       uint32(syncPageSize()),
+	})
+	c.Send(packet, reply, err)
+}
@@ -0,0 +1,58 @@
+package connect
+
+import (
+	"context"
+	"testing"
+
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/messagepb"
+	"im-server/pkg/rpc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeSyncMessageExt 记录同步请求，并总是回复还有剩余消息
+type fakeSyncMessageExt struct {
+	messagepb.MessageExtServiceClient
+	reqs []*messagepb.SyncMessagesRequest
+}
+
+func (f *fakeSyncMessageExt) SyncMessages(ctx context.Context, in *messagepb.SyncMessagesRequest, opts ...grpc.CallOption) (*messagepb.SyncMessagesReply, error) {
+	f.reqs = append(f.reqs, in)
+	return &messagepb.SyncMessagesReply{
+		HasMore:     true,
+		Cursors:     []*messagepb.SyncCursor{{ConversationId: "c_1_2", Seq: 50}},
+		InboxCursor: 1700000000000,
+	}, nil
+}
+
+func TestSyncRepliesOnePage(t *testing.T) {
+	fake := &fakeSyncMessageExt{}
+	rpc.SetMessageExtServiceClient(fake)
+
+	conn, tr := newTestConn(t)
+	data, err := proto.Marshal(&connectpb.SyncInput{InboxCursor: 1600000000000})
+	require.NoError(t, err)
+	buf, err := proto.Marshal(&connectpb.Packet{Command: connectpb.Command_SYNC, RequestId: 5, Data: data})
+	require.NoError(t, err)
+
+	conn.HandleMessage(buf)
+
+	// 即使还有剩余消息，也只拉取并回复一批，由客户端以回复中的游标继续
+	require.Len(t, fake.reqs, 1)
+	assert.Equal(t, int64(1600000000000), fake.reqs[0].InboxCursor)
+	assert.Equal(t, uint32(syncPageSize()), fake.reqs[0].Limit)
+
+	writes, _ := tr.state()
+	require.Equal(t, 1, writes)
+	pkt := lastPacket(t, tr)
+	assert.Equal(t, int64(5), pkt.RequestId)
+	var reply messagepb.SyncMessagesReply
+	require.NoError(t, proto.Unmarshal(pkt.Data, &reply))
+	assert.True(t, reply.HasMore)
+	assert.Equal(t, int64(50), reply.Cursors[0].Seq)
+	assert.Equal(t, int64(1700000000000), reply.InboxCursor)
+}
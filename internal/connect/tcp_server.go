@@ -0,0 +1,164 @@
+package connect
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/jwt"
+	"im-server/pkg/protocol/pb/connectpb"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// tcpHeaderLen 帧头长度：4 字节大端序的包体长度
+	tcpHeaderLen = 4
+	// maxTCPFrameSize 单帧包体的最大长度，超过视为非法连接
+	maxTCPFrameSize = 4 << 20
+	// tcpHandshakeTimeout 建立连接后等待首帧 SIGN_IN 的超时时间
+	tcpHandshakeTimeout = 10 * time.Second
+)
+
+var errFrameTooLarge = errors.New("tcp frame too large")
+
+// TCPTransport 是 Transport 接口针对 TCP 长连接的具体实现，
+// 每个 connectpb.Packet 以「4 字节大端序长度 + 包体」的帧格式传输。
+type TCPTransport struct {
+	Mutex  sync.Mutex // TCP写锁，保证并发写入的线程安全
+	Conn   net.Conn   // 底层的 TCP 连接
+	reader *bufio.Reader
+}
+
+func NewTCPTransport(conn net.Conn) *TCPTransport {
+	return &TCPTransport{Conn: conn, reader: bufio.NewReader(conn)}
+}
+
+// Write 以线程安全的方式为本次写入设置超时，并将 buf 封装为一帧写出。
+func (t *TCPTransport) Write(buf []byte) error {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+	err := t.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	if err != nil {
+		return err
+	}
+	frame := make([]byte, tcpHeaderLen+len(buf))
+	binary.BigEndian.PutUint32(frame, uint32(len(buf)))
+	copy(frame[tcpHeaderLen:], buf)
+	_, err = t.Conn.Write(frame)
+	return err
+}
+
+// Close 关闭底层的 TCP 连接。
+func (t *TCPTransport) Close() error {
+	return t.Conn.Close()
+}
+
+// RemoteAddr 返回 TCP 连接的远端网络地址。
+func (t *TCPTransport) RemoteAddr() net.Addr {
+	return t.Conn.RemoteAddr()
+}
+
+// SetReadDeadline 设置底层连接的读取超时时间。
+func (t *TCPTransport) SetReadDeadline(tm time.Time) error {
+	return t.Conn.SetReadDeadline(tm)
+}
+
+// ReadMessage 从 TCP 连接中读取一个完整的帧并返回包体。
+func (t *TCPTransport) ReadMessage() ([]byte, error) {
+	var header [tcpHeaderLen]byte
+	if _, err := io.ReadFull(t.reader, header[:]); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint32(header[:])
+	if n > maxTCPFrameSize {
+		return nil, errFrameTooLarge
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(t.reader, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// StartTCPConn 是处理新 TCP 连接的入口函数。
+// TCP 连接没有 HTTP 升级阶段，首帧必须是携带 token 的 SIGN_IN，认证通过后才进入正常的服务循环。
+func StartTCPConn(nc net.Conn) {
+	conn := &Conn{
+		Session:   &Session{},
+		Transport: NewTCPTransport(nc),
+	}
+	conn.Inflight = newInflight(conn)
+	conn.startWriter()
+
+	if err := conn.handshake(); err != nil {
+		slog.Error("tcp handshake failed", "err", err, "clientAddr", nc.RemoteAddr().String())
+		close(conn.done) // 未登录的连接无需清理会话，写协程退出时关闭底层连接
+		return
+	}
+	conn.Serve()
+}
+
+// handshake 读取并处理首帧 SIGN_IN：先在本地校验 token，再走与 WebSocket 相同的 SIGN_IN 流程登记设备
+func (c *Conn) handshake() error {
+	if err := c.Transport.SetReadDeadline(time.Now().Add(tcpHandshakeTimeout)); err != nil {
+		return err
+	}
+	buf, err := c.Transport.ReadMessage()
+	if err != nil {
+		return err
+	}
+	packet := new(connectpb.Packet)
+	if err := proto.Unmarshal(buf, packet); err != nil {
+		return err
+	}
+	if packet.Command != connectpb.Command_SIGN_IN {
+		return fmt.Errorf("first frame must be SIGN_IN, got %s", packet.Command)
+	}
+	var input connectpb.SignInInput
+	if err := proto.Unmarshal(packet.Data, &input); err != nil {
+		return err
+	}
+	uid, did, err := jwt.ParseJWT(input.Token, []byte(config.Config.JWT.Secret), config.Config.JWT.Issuer, config.Config.JWT.Audience)
+	if err != nil {
+		return fmt.Errorf("invalid token: %w", err)
+	}
+	if uid != input.UserId || (did != 0 && did != input.DeviceId) {
+		return errors.New("token does not match sign in input")
+	}
+
+	c.SignIn(packet)
+	if c.Session.UserID == 0 {
+		return errors.New("sign in rejected")
+	}
+	return nil
+}
+
+// StartTCPServer 启动 TCP 长连接服务，协议与 WebSocket 相同，仅帧格式不同
+func StartTCPServer(addr string) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		slog.Error("start tcp server", "err", err)
+		return
+	}
+	registerListener(ln)
+	slog.Info("tcp server running", "addr", addr)
+	for {
+		nc, err := ln.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			slog.Error("tcp accept", "err", err)
+			continue
+		}
+		go StartTCPConn(nc)
+	}
+}
@@ -0,0 +1,89 @@
+package connect
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/jwt"
+	"im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/rpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// dialTCP 启动 TCP 服务并建立一个客户端连接
+func dialTCP(t *testing.T) *TCPTransport {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	go StartTCPServer(addr)
+	var nc net.Conn
+	require.Eventually(t, func() bool {
+		nc, err = net.Dial("tcp", addr)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	t.Cleanup(func() { nc.Close() })
+	return NewTCPTransport(nc)
+}
+
+func writePacket(t *testing.T, tr *TCPTransport, pkt *connectpb.Packet) {
+	buf, err := proto.Marshal(pkt)
+	require.NoError(t, err)
+	require.NoError(t, tr.Write(buf))
+}
+
+func readPacket(t *testing.T, tr *TCPTransport) *connectpb.Packet {
+	require.NoError(t, tr.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf, err := tr.ReadMessage()
+	require.NoError(t, err)
+	pkt := new(connectpb.Packet)
+	require.NoError(t, proto.Unmarshal(buf, pkt))
+	return pkt
+}
+
+func TestTCPSignInAndHeartbeat(t *testing.T) {
+	config.Config.Services.Connect.LocalAddr = "127.0.0.1:50055"
+
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockDeviceIntServiceClient(ctrl)
+	mockClient.EXPECT().ConnSignIn(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockClient.EXPECT().Heartbeat(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockClient.EXPECT().Offline(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	rpc.SetDeviceIntServiceClient(mockClient)
+
+	token, err := jwt.GenerateJWT(67890, 12345, []byte(config.Config.JWT.Secret), time.Hour, config.Config.JWT.Issuer, config.Config.JWT.Audience)
+	require.NoError(t, err)
+
+	client := dialTCP(t)
+	data, _ := proto.Marshal(&connectpb.SignInInput{DeviceId: 12345, UserId: 67890, Token: token})
+	writePacket(t, client, &connectpb.Packet{Command: connectpb.Command_SIGN_IN, RequestId: 1, Data: data})
+
+	reply := readPacket(t, client)
+	assert.Equal(t, connectpb.Command_SIGN_IN, reply.Command)
+	assert.Equal(t, int64(1), reply.RequestId)
+	assert.Equal(t, uint32(0), reply.Code)
+	require.Eventually(t, func() bool { return GetConnection(12345) != nil }, time.Second, 10*time.Millisecond)
+	defer GetConnection(12345).Close()
+
+	writePacket(t, client, &connectpb.Packet{Command: connectpb.Command_HEARTBEAT, RequestId: 2})
+	pong := readPacket(t, client)
+	assert.Equal(t, connectpb.Command_HEARTBEAT, pong.Command)
+	assert.Equal(t, int64(2), pong.RequestId)
+}
+
+func TestTCPRejectsNonSignInFirstFrame(t *testing.T) {
+	client := dialTCP(t)
+	writePacket(t, client, &connectpb.Packet{Command: connectpb.Command_HEARTBEAT, RequestId: 1})
+
+	require.NoError(t, client.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, err := client.ReadMessage()
+	assert.Error(t, err)
+}
@@ -0,0 +1,163 @@
+package connect
+
+import (
+	"errors"
+	"expvar"
+	"log/slog"
+
+	"im-server/pkg/config"
+	"im-server/pkg/protocol/pb/connectpb"
+)
+
+// 写队列溢出策略
+const (
+	OverflowClose      = "close"       // 关闭连接，客户端重连后离线同步
+	OverflowDropOldest = "drop_oldest" // 丢弃队列中最旧的数据包，MESSAGE 推送由重传机制补发
+	OverflowSpill      = "spill"       // 丢弃新数据包，队列排空后通知客户端发起 SYNC
+)
+
+const defaultWriteQueueSize = 256
+
+var (
+	errWriteQueueFull = errors.New("write queue full")
+	errConnClosed     = errors.New("connection closed")
+)
+
+// writeQueueOverflow 按溢出策略统计的写队列溢出次数
+var writeQueueOverflow = expvar.NewMap("connect_write_queue_overflow")
+
+// 写队列深度指标，随 expvar 暴露在管理端口的 /debug/vars 下
+func init() {
+	expvar.Publish("connect_write_queue", expvar.Func(writeQueueStats))
+}
+
+// writeQueueStats 汇总本节点全部连接的写队列深度
+func writeQueueStats() any {
+	conns, depth, maxDepth := 0, 0, 0
+	ConnectManager.Range(func(key, value any) bool {
+		n := value.(*Conn).QueueDepth()
+		conns++
+		depth += n
+		maxDepth = max(maxDepth, n)
+		return true
+	})
+	return map[string]int{"conns": conns, "depth": depth, "max_depth": maxDepth}
+}
+
+// writeQueueSize 单连接写队列容量
+func writeQueueSize() int {
+	if n := config.Config.Services.Connect.WriteQueueSize; n > 0 {
+		return n
+	}
+	return defaultWriteQueueSize
+}
+
+// overflowPolicy 写队列溢出策略，未配置或无法识别时关闭连接
+func overflowPolicy() string {
+	switch p := config.Config.Services.Connect.WriteOverflowPolicy; p {
+	case OverflowDropOldest, OverflowSpill:
+		return p
+	default:
+		return OverflowClose
+	}
+}
+
+// syncHint 队列溢出丢弃推送后下发的 SYNC 通知，不携带数据
+var syncHint = &connectpb.Packet{Command: connectpb.Command_SYNC}
+
+// startWriter 为连接创建有界写队列并启动专属的写协程，
+// 此后 Write 只负责入队，慢客户端不会阻塞调用方（如 Kafka 消费协程）
+func (c *Conn) startWriter() {
+	c.queue = make(chan []byte, writeQueueSize())
+	c.done = make(chan struct{})
+	c.flushed = make(chan struct{})
+	trackConn(c)
+	go c.writeLoop()
+}
+
+// QueueDepth 返回写队列中等待写出的数据包数
+func (c *Conn) QueueDepth() int {
+	return len(c.queue)
+}
+
+// enqueue 将数据包放入写队列，队列已满时按溢出策略处理
+func (c *Conn) enqueue(buf []byte) error {
+	select {
+	case <-c.done:
+		return errConnClosed
+	default:
+	}
+
+	select {
+	case c.queue <- buf:
+		return nil
+	default:
+	}
+
+	policy := overflowPolicy()
+	writeQueueOverflow.Add(policy, 1)
+	switch policy {
+	case OverflowDropOldest:
+		for {
+			select {
+			case c.queue <- buf:
+				return nil
+			default:
+			}
+			select {
+			case <-c.queue:
+			default:
+			}
+		}
+	case OverflowSpill:
+		c.needSync.Store(true)
+		return errWriteQueueFull
+	default:
+		slog.Warn("write queue full, close connection", "deviceID", c.Session.DeviceID, "depth", len(c.queue))
+		c.Close()
+		return errWriteQueueFull
+	}
+}
+
+// writeLoop 依次写出队列中的数据包。连接关闭后尽量写完已入队的数据（如 KICKED 通知）再关闭底层连接
+func (c *Conn) writeLoop() {
+	defer close(c.flushed)
+	defer untrackConn(c)
+	defer c.Transport.Close()
+	for {
+		select {
+		case buf := <-c.queue:
+			if !c.writeNow(buf) {
+				return
+			}
+		case <-c.done:
+			for {
+				select {
+				case buf := <-c.queue:
+					if !c.writeNow(buf) {
+						return
+					}
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// writeNow 同步写出一个数据包，队列排空且有被丢弃的推送时补发 SYNC 通知。写失败时关闭连接
+func (c *Conn) writeNow(buf []byte) bool {
+	err := c.Transport.Write(buf)
+	if err == nil && len(c.queue) == 0 && c.needSync.CompareAndSwap(true, false) {
+		var hint []byte
+		if hint, err = c.Codec().Encode(syncHint, nil); err == nil {
+			err = c.Transport.Write(hint)
+		}
+	}
+	if err != nil {
+		slog.Error("write error", "error", err, "deviceID", c.Session.DeviceID)
+		c.Close()
+		return false
+	}
+	return true
+}
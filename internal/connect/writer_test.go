@@ -0,0 +1,97 @@
+package connect
+
+import (
+	"testing"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/protocol/pb/connectpb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// blockingTransport 在 release 关闭前阻塞写入，模拟慢客户端
+type blockingTransport struct {
+	fakeTransport
+	release chan struct{}
+}
+
+func (t *blockingTransport) Write(buf []byte) error {
+	<-t.release
+	return t.fakeTransport.Write(buf)
+}
+
+// newQueuedConn 创建写队列容量为 size 的测试连接，首个数据包会阻塞在写协程中
+func newQueuedConn(t *testing.T, size int, policy string) (*Conn, *blockingTransport) {
+	config.Config.Services.Connect.WriteQueueSize = size
+	config.Config.Services.Connect.WriteOverflowPolicy = policy
+	t.Cleanup(func() {
+		config.Config.Services.Connect.WriteQueueSize = 0
+		config.Config.Services.Connect.WriteOverflowPolicy = ""
+	})
+
+	conn, _ := newTestConn(t)
+	tr := &blockingTransport{release: make(chan struct{})}
+	conn.Transport = tr
+	conn.startWriter()
+
+	// 首个数据包被写协程取出并阻塞，此后队列中的数据包都在排队
+	require.NoError(t, conn.Write([]byte("head")))
+	require.Eventually(t, func() bool { return conn.QueueDepth() == 0 }, time.Second, time.Millisecond)
+	return conn, tr
+}
+
+func writtenPackets(tr *blockingTransport) []string {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	out := make([]string, len(tr.writes))
+	for i, w := range tr.writes {
+		out[i] = string(w)
+	}
+	return out
+}
+
+func TestWriteQueueDropOldest(t *testing.T) {
+	conn, tr := newQueuedConn(t, 2, OverflowDropOldest)
+	for _, p := range []string{"a", "b", "c"} {
+		require.NoError(t, conn.Write([]byte(p)))
+	}
+	assert.Equal(t, 2, conn.QueueDepth())
+
+	close(tr.release)
+	assert.Eventually(t, func() bool { return len(writtenPackets(tr)) == 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"head", "b", "c"}, writtenPackets(tr))
+	conn.Close()
+}
+
+func TestWriteQueueSpillSendsSyncHint(t *testing.T) {
+	conn, tr := newQueuedConn(t, 1, OverflowSpill)
+	require.NoError(t, conn.Write([]byte("a")))
+	assert.ErrorIs(t, conn.Write([]byte("b")), errWriteQueueFull)
+
+	close(tr.release)
+	assert.Eventually(t, func() bool { return len(writtenPackets(tr)) == 3 }, time.Second, time.Millisecond)
+	written := writtenPackets(tr)
+	assert.Equal(t, []string{"head", "a"}, written[:2])
+	var hint connectpb.Packet
+	require.NoError(t, proto.Unmarshal([]byte(written[2]), &hint))
+	assert.Equal(t, connectpb.Command_SYNC, hint.Command)
+	conn.Close()
+}
+
+func TestWriteQueueCloseFlushesPending(t *testing.T) {
+	conn, tr := newQueuedConn(t, 1, OverflowClose)
+	require.NoError(t, conn.Write([]byte("kicked")))
+	assert.ErrorIs(t, conn.Write([]byte("overflow")), errWriteQueueFull)
+	assert.ErrorIs(t, conn.Write([]byte("late")), errConnClosed)
+
+	// 关闭后写协程仍会写完已入队的数据，再关闭底层连接
+	close(tr.release)
+	assert.Eventually(t, func() bool {
+		_, closed := tr.state()
+		return closed
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"head", "kicked"}, writtenPackets(tr))
+}
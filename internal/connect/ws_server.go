@@ -1,6 +1,7 @@
 package connect
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
 
@@ -13,6 +14,7 @@ import (
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 65536,
+	Subprotocols:    subprotocols(),
 	CheckOrigin: func(r *http.Request) bool {
 		// 允许跨域，生产环境按需收紧
 		return true
@@ -21,6 +23,11 @@ var upgrader = websocket.Upgrader{
 
 func wsHandler(w http.ResponseWriter, r *http.Request) {
 	slog.Info("wsHandler has been called, attempting to upgrade connection...")
+	// 节点排空期间不再接受新连接，客户端应重连到其他节点
+	if Draining() {
+		http.Error(w, "server draining", http.StatusServiceUnavailable)
+		return
+	}
 	// 1) 从查询参数读取 token 并校验
 	token := r.URL.Query().Get("token")
 	if token == "" {
@@ -34,22 +41,39 @@ func wsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 2) 升级为 WebSocket
+	// 2) 协商编码格式：查询参数 codec 优先，其次是子协议
+	codec, err := requestedCodec(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// 3) 升级为 WebSocket
 	wsConn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		slog.Error("upgrade failed", "err", err)
 		return
 	}
+	codec = negotiateCodec(codec, wsConn)
 
-	// 3) 构建已认证会话并启动连接（StartWSConn 会在 session 带 deviceID 时完成注册）
-	StartWSConn(wsConn, &Session{UserID: uid, DeviceID: did})
+	// 4) 构建已认证会话并启动连接（StartWSConn 会在 session 带 deviceID 时完成注册）
+	StartWSConn(wsConn, &Session{UserID: uid, DeviceID: did, Token: token}, codec)
+
+}
 
+// wsMux 面向客户端的路由，只注册 /ws，不使用挂载了 expvar 等调试接口的 http.DefaultServeMux
+func wsMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ws", wsHandler)
+	return mux
 }
 
 func StartWSServer(addr string) {
-	http.HandleFunc("/ws", wsHandler)
+	upgrader.EnableCompression = config.Config.Services.Connect.Compression
+	server := &http.Server{Addr: addr, Handler: wsMux()}
+	registerListener(server)
 	slog.Info("websocket server running", "addr", addr)
-	if err := http.ListenAndServe(addr, nil); err != nil {
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		slog.Error("start ws server", "err", err)
 	}
 }
@@ -2,8 +2,10 @@ package device
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
+	"im-server/pkg/config"
 	"im-server/pkg/dao"
+	"im-server/pkg/jwt"
 	"im-server/pkg/protocol/pb/devicepb"
 
 	"google.golang.org/grpc/codes"
@@ -29,32 +31,108 @@ func NewDeviceIntService(queries *dao.Queries) *DeviceIntService {
 //在DeviceIntService结构体中添加queries字段
 //通过函数的receiver访问queries再访问数据库函数
 
-func (s *DeviceIntService) ConnSignIn(ctx context.Context, req *devicepb.ConnSignInRequest) (*emptypb.Empty, error) {
-	// JWT 认证已在拦截器中完成，此处直接从 context 获取用户信息
-	userID, ok := ctx.Value("user_id").(uint64)
-	if !ok {
-		return nil, fmt.Errorf("user not authenticated")
+func (s *DeviceIntService) ConnSignIn(ctx context.Context, req *devicepb.ConnSignInRequest) (*devicepb.ConnSignInReply, error) {
+	// DeviceIntService 仅供 connect 层调用，不经过 JWT 拦截器，这里直接校验连接携带的 token
+	userID, deviceID, err := jwt.ParseJWT(req.Token, []byte(config.Config.JWT.Secret), config.Config.JWT.Issuer, config.Config.JWT.Audience)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	if userID != req.UserId || (deviceID != 0 && deviceID != req.DeviceId) {
+		return nil, status.Error(codes.PermissionDenied, "token does not match device")
 	}
 
 	device, err := s.queries.GetDevice(ctx, req.DeviceId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get device: %v", err)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "device not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get device: %v", err)
 	}
 
 	// 验证设备是否属于当前用户
 	if device.UserID != userID {
-		return nil, fmt.Errorf("device does not belong to user")
+		return nil, status.Error(codes.PermissionDenied, "device does not belong to user")
+	}
+
+	// 记录设备当前所在的 connect 节点，用于跨节点投递路由
+	device.ConnAddr = req.ConnAddr
+	device.ClientAddr = req.ClientAddr
+
+	// 按多端登录策略找出被顶替的设备，由 connect 层下发 KICKED 后断开
+	online, err := ListUserOnlineDevices(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list online devices: %v", err)
+	}
+	superseded := supersededDevices(online, &device)
+	for _, d := range superseded {
+		if d.Device.DeviceId == device.ID {
+			continue
+		}
+		if err := SetDeviceOffline(ctx, userID, d.Device.DeviceId); err != nil {
+			return nil, status.Errorf(codes.Internal, "set device offline: %v", err)
+		}
 	}
 
 	err = SetDeviceOnline(ctx, &device)
 	if err != nil {
-		return nil, fmt.Errorf("failed to set device online: %v", err)
+		return nil, status.Errorf(codes.Internal, "set device online: %v", err)
 	}
 
-	// TODO: 实现登录逻辑
-	return new(emptypb.Empty), nil
+	return &devicepb.ConnSignInReply{Superseded: superseded}, nil
 }
 
+// Offline 设备断开连接时标记离线；若设备已在其他连接上重新登录，则忽略旧连接的离线上报
 func (s *DeviceIntService) Offline(ctx context.Context, req *devicepb.OfflineRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Offline not implemented")
+	device, err := GetDeviceOnline(ctx, req.DeviceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get device online: %v", err)
+	}
+	if device != nil && req.ClientAddr != "" && device.ClientAddr != req.ClientAddr {
+		return new(emptypb.Empty), nil
+	}
+	if err := SetDeviceOffline(ctx, req.UserId, req.DeviceId); err != nil {
+		return nil, status.Errorf(codes.Internal, "set device offline: %v", err)
+	}
+	return new(emptypb.Empty), nil
+}
+
+// BatchOffline 批量设置设备离线，已在其他连接重新登录的设备会被跳过
+func (s *DeviceIntService) BatchOffline(ctx context.Context, req *devicepb.BatchOfflineRequest) (*emptypb.Empty, error) {
+	for _, d := range req.Devices {
+		if _, err := s.Offline(ctx, d); err != nil {
+			return nil, err
+		}
+	}
+	return new(emptypb.Empty), nil
+}
+
+// ListOnlineDevices 批量查询用户的在线设备及其所在的 connect 节点
+func (s *DeviceIntService) ListOnlineDevices(ctx context.Context, req *devicepb.ListOnlineDevicesRequest) (*devicepb.ListOnlineDevicesReply, error) {
+	reply := &devicepb.ListOnlineDevicesReply{}
+	for _, uid := range req.UserIds {
+		devices, err := ListUserOnlineDevices(ctx, uid)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "list online devices: %v", err)
+		}
+		for _, d := range devices {
+			reply.Devices = append(reply.Devices, &devicepb.OnlineDevice{
+				DeviceId: d.ID,
+				UserId:   d.UserID,
+				ConnAddr: d.ConnAddr,
+			})
+		}
+	}
+	return reply, nil
+}
+
+// Heartbeat 续期设备在线状态，在线信息已过期时返回 NotFound，客户端需重新登录
+func (s *DeviceIntService) Heartbeat(ctx context.Context, req *devicepb.HeartbeatRequest) (*emptypb.Empty, error) {
+	ok, err := RefreshDeviceOnline(ctx, req.DeviceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "refresh device online: %v", err)
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "device not online")
+	}
+	return new(emptypb.Empty), nil
 }
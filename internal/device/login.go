@@ -0,0 +1,87 @@
+package device
+
+import (
+	"sort"
+
+	"im-server/pkg/config"
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/devicepb"
+)
+
+// 设备类别，多端登录策略按类别限制同时在线的设备数
+const (
+	deviceClassMobile  = "mobile"
+	deviceClassDesktop = "desktop"
+	deviceClassWeb     = "web"
+)
+
+// 被顶替设备收到的下线原因
+const (
+	reasonDeviceReconnected = "同一设备在其他连接重新登录"
+	reasonLoginElsewhere    = "账号已在其他同类设备登录"
+)
+
+// deviceClass 将 device.type（1:Android 2:IOS 3:Windows 4:MacOS 5:Web）归类
+func deviceClass(deviceType int8) string {
+	switch deviceType {
+	case 1, 2:
+		return deviceClassMobile
+	case 3, 4:
+		return deviceClassDesktop
+	case 5:
+		return deviceClassWeb
+	}
+	return ""
+}
+
+// loginLimit 某类设备允许同时在线的数量，0 表示不限
+func loginLimit(class string) int {
+	if class == "" {
+		return 0
+	}
+	return config.Config.Services.Device.LoginPolicy[class]
+}
+
+// supersededDevices 计算 device 登录后需要被踢下线的在线设备：
+// 同一设备在其他 connect 节点上的旧连接，以及超出同类设备在线数量限制的最早登录的设备。
+// 同一设备在同一节点上的旧连接由 connect 节点在替换连接时自行处理
+func supersededDevices(online []*dao.Device, device *dao.Device) []*devicepb.SupersededDevice {
+	var (
+		superseded []*devicepb.SupersededDevice
+		sameClass  []*dao.Device
+	)
+	class := deviceClass(device.Type)
+	for _, d := range online {
+		if d.ID == device.ID {
+			if d.ConnAddr != "" && d.ConnAddr != device.ConnAddr {
+				superseded = append(superseded, supersede(d, reasonDeviceReconnected))
+			}
+			continue
+		}
+		if class != "" && deviceClass(d.Type) == class {
+			sameClass = append(sameClass, d)
+		}
+	}
+
+	limit := loginLimit(class)
+	if limit == 0 || len(sameClass) < limit {
+		return superseded
+	}
+	// 保留最近登录的 limit-1 台，其余按登录时间从早到晚踢下线
+	sort.Slice(sameClass, func(i, j int) bool { return sameClass[i].CreatedAt.Before(sameClass[j].CreatedAt) })
+	for _, d := range sameClass[:len(sameClass)-limit+1] {
+		superseded = append(superseded, supersede(d, reasonLoginElsewhere))
+	}
+	return superseded
+}
+
+func supersede(d *dao.Device, reason string) *devicepb.SupersededDevice {
+	return &devicepb.SupersededDevice{
+		Device: &devicepb.OnlineDevice{
+			DeviceId: d.ID,
+			UserId:   d.UserID,
+			ConnAddr: d.ConnAddr,
+		},
+		Reason: reason,
+	}
+}
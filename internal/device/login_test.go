@@ -0,0 +1,81 @@
+package device
+
+import (
+	"testing"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/devicepb"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupersededDevices(t *testing.T) {
+	policy := config.Config.Services.Device.LoginPolicy
+	config.Config.Services.Device.LoginPolicy = map[string]int{deviceClassMobile: 1, deviceClassDesktop: 2}
+	defer func() { config.Config.Services.Device.LoginPolicy = policy }()
+
+	now := time.Now()
+	online := func(id uint64, deviceType int8, addr string, loginAgo time.Duration) *dao.Device {
+		return &dao.Device{ID: id, UserID: 1, Type: deviceType, ConnAddr: addr, CreatedAt: now.Add(-loginAgo)}
+	}
+	superseded := func(id uint64, addr, reason string) *devicepb.SupersededDevice {
+		return &devicepb.SupersededDevice{Device: &devicepb.OnlineDevice{DeviceId: id, UserId: 1, ConnAddr: addr}, Reason: reason}
+	}
+
+	tests := []struct {
+		name   string
+		online []*dao.Device
+		device *dao.Device
+		want   []*devicepb.SupersededDevice
+	}{
+		{
+			name:   "same device on another node",
+			online: []*dao.Device{online(10, 3, "node-b", time.Minute)},
+			device: online(10, 3, "node-a", 0),
+			want:   []*devicepb.SupersededDevice{superseded(10, "node-b", reasonDeviceReconnected)},
+		},
+		{
+			name:   "same device on the same node",
+			online: []*dao.Device{online(10, 3, "node-a", time.Minute)},
+			device: online(10, 3, "node-a", 0),
+		},
+		{
+			name:   "class limit reached",
+			online: []*dao.Device{online(11, 1, "node-b", time.Minute)},
+			device: online(12, 2, "node-a", 0),
+			want:   []*devicepb.SupersededDevice{superseded(11, "node-b", reasonLoginElsewhere)},
+		},
+		{
+			name: "oldest evicted",
+			online: []*dao.Device{
+				online(21, 3, "node-a", time.Minute),
+				online(22, 4, "node-b", time.Hour),
+				online(23, 1, "node-b", 2*time.Hour),
+			},
+			device: online(24, 3, "node-a", 0),
+			want:   []*devicepb.SupersededDevice{superseded(22, "node-b", reasonLoginElsewhere)},
+		},
+		{
+			name:   "below class limit",
+			online: []*dao.Device{online(31, 4, "node-b", time.Minute)},
+			device: online(32, 3, "node-a", 0),
+		},
+		{
+			name:   "unlimited class",
+			online: []*dao.Device{online(41, 5, "node-b", time.Hour), online(42, 5, "node-b", time.Minute)},
+			device: online(43, 5, "node-a", 0),
+		},
+		{
+			name:   "unknown device type",
+			online: []*dao.Device{online(51, 9, "node-b", time.Hour), online(52, 1, "node-b", time.Minute)},
+			device: online(53, 9, "node-a", 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, supersededDevices(tt.online, tt.device))
+		})
+	}
+}
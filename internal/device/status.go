@@ -2,6 +2,7 @@ package device
 
 import (
 	"context"
+	"im-server/pkg/config"
 	"im-server/pkg/dao"
 	redisPkg "im-server/pkg/redis"
 	"strconv"
@@ -11,11 +12,48 @@ import (
 )
 
 const (
-	deviceInfoKey = "device:info:"
-	OnLine        = 1 // 设备在线
-	OffLine       = 0 // 设备离线
+	deviceInfoKey  = "device:info:"
+	userDevicesKey = "user:devices:" // 用户在线设备集合
+	OnLine         = 1               // 设备在线
+	OffLine        = 0               // 设备离线
+
+	defaultIdleTimeout       = 12 * time.Minute
+	defaultHeartbeatInterval = 30 * time.Second
 )
 
+// refreshOnlineScript 仅在设备状态为在线时续期并更新心跳时间，返回 0 表示已过期或已离线
+var refreshOnlineScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], "status") ~= ARGV[1] then
+	return 0
+end
+redis.call("PEXPIRE", KEYS[1], ARGV[2])
+redis.call("HSET", KEYS[1], "updated_at", ARGV[3])
+return 1
+`)
+
+// setOfflineScript 仅在在线信息仍存在时写入离线状态，避免重建一个没有过期时间的 key
+var setOfflineScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	redis.call("HSET", KEYS[1], "status", ARGV[1], "updated_at", ARGV[2])
+end
+redis.call("SREM", KEYS[2], ARGV[3])
+return 1
+`)
+
+// onlineTTL 设备在线状态的有效期，由心跳续期。比 connect 层的连接空闲超时多一个心跳间隔，
+// 保证连接断开前在线状态不会先过期
+func onlineTTL() time.Duration {
+	idle, err := time.ParseDuration(config.Config.Services.Connect.IdleTimeout)
+	if err != nil || idle <= 0 {
+		idle = defaultIdleTimeout
+	}
+	interval, err := time.ParseDuration(config.Config.Services.Connect.HeartbeatInterval)
+	if err != nil || interval <= 0 {
+		interval = defaultHeartbeatInterval
+	}
+	return idle + interval
+}
+
 // SetDeviceOnline 设置设备在线信息到redis
 func SetDeviceOnline(ctx context.Context, device *dao.Device) error {
 	key := deviceInfoKey + strconv.FormatUint(device.ID, 10)
@@ -24,22 +62,65 @@ func SetDeviceOnline(ctx context.Context, device *dao.Device) error {
 
 	fields := map[string]interface{}{
 		"user_id":     device.UserID,
+		"type":        device.Type,
 		"status":      device.Status,
 		"conn_addr":   device.ConnAddr,
 		"client_addr": device.ClientAddr,
+		"login_at":    device.UpdatedAt.UnixMilli(), // 本次登录时间，多端登录策略据此顶替最早登录的设备
 		"updated_at":  device.UpdatedAt.Unix(),
 	}
-	return redisPkg.RedisClient.HSet(ctx, key, fields).Err()
+	pipe := redisPkg.RedisClient.TxPipeline()
+	pipe.HSet(ctx, key, fields)
+	pipe.Expire(ctx, key, onlineTTL())
+	pipe.SAdd(ctx, userDevicesKey+strconv.FormatUint(device.UserID, 10), device.ID)
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
-// SetDeviceOffline 设置设备离线
-func SetDeviceOffline(ctx context.Context, deviceID uint64) error {
+// RefreshDeviceOnline 续期设备在线状态，返回 false 表示在线信息已过期或设备已离线
+func RefreshDeviceOnline(ctx context.Context, deviceID uint64) (bool, error) {
 	key := deviceInfoKey + strconv.FormatUint(deviceID, 10)
-	fields := map[string]interface{}{
-		"status":     OffLine,
-		"updated_at": time.Now().Unix(),
+	ret, err := refreshOnlineScript.Run(ctx, redisPkg.RedisClient, []string{key},
+		OnLine, onlineTTL().Milliseconds(), time.Now().Unix()).Int()
+	if err != nil {
+		return false, err
+	}
+	return ret == 1, nil
+}
+
+// SetDeviceOffline 设置设备离线，并从用户在线设备集合中移除。在线信息已过期时不再写入
+func SetDeviceOffline(ctx context.Context, userID, deviceID uint64) error {
+	keys := []string{
+		deviceInfoKey + strconv.FormatUint(deviceID, 10),
+		userDevicesKey + strconv.FormatUint(userID, 10),
 	}
-	return redisPkg.RedisClient.HSet(ctx, key, fields).Err()
+	return setOfflineScript.Run(ctx, redisPkg.RedisClient, keys, OffLine, time.Now().Unix(), deviceID).Err()
+}
+
+// ListUserOnlineDevices 获取用户的全部在线设备，顺带清理集合中已过期或已离线的设备
+func ListUserOnlineDevices(ctx context.Context, userID uint64) ([]*dao.Device, error) {
+	setKey := userDevicesKey + strconv.FormatUint(userID, 10)
+	members, err := redisPkg.RedisClient.SMembers(ctx, setKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	devices := make([]*dao.Device, 0, len(members))
+	for _, m := range members {
+		deviceID, err := strconv.ParseUint(m, 10, 64)
+		if err != nil {
+			continue
+		}
+		device, err := GetDeviceOnline(ctx, deviceID)
+		if err != nil {
+			return nil, err
+		}
+		if device == nil || device.Status != OnLine || device.UserID != userID {
+			redisPkg.RedisClient.SRem(ctx, setKey, m)
+			continue
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
 }
 
 // GetDeviceOnline 获取设备在线信息
@@ -60,9 +141,15 @@ func GetDeviceOnline(ctx context.Context, deviceID uint64) (*dao.Device, error)
 	if userID, err := strconv.ParseUint(ret["user_id"], 10, 64); err == nil {
 		device.UserID = userID
 	}
+	if deviceType, err := strconv.ParseInt(ret["type"], 10, 8); err == nil {
+		device.Type = int8(deviceType)
+	}
 	if status, err := strconv.ParseInt(ret["status"], 10, 8); err == nil {
 		device.Status = int8(status)
 	}
+	if loginAt, err := strconv.ParseInt(ret["login_at"], 10, 64); err == nil {
+		device.CreatedAt = time.UnixMilli(loginAt) // 在线信息中 CreatedAt 表示本次登录时间
+	}
 	device.ConnAddr = ret["conn_addr"]
 	device.ClientAddr = ret["client_addr"]
 	if updatedAt, err := strconv.ParseInt(ret["updated_at"], 10, 64); err == nil {
@@ -0,0 +1,52 @@
+package device
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"im-server/pkg/dao"
+	redisPkg "im-server/pkg/redis"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDeviceOfflineExpired(t *testing.T) {
+	ctx := context.Background()
+	const userID, deviceID = 900001, 900001
+	key := deviceInfoKey + strconv.FormatUint(deviceID, 10)
+	redisPkg.RedisClient.Del(ctx, key)
+
+	// 在线信息已过期时不应重建一个没有过期时间的 key
+	require.NoError(t, SetDeviceOffline(ctx, userID, deviceID))
+	n, err := redisPkg.RedisClient.Exists(ctx, key).Result()
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestRefreshDeviceOnline(t *testing.T) {
+	ctx := context.Background()
+	device := &dao.Device{ID: 900002, UserID: 900002, Type: 1, ConnAddr: "127.0.0.1:8080"}
+	key := deviceInfoKey + strconv.FormatUint(device.ID, 10)
+	defer redisPkg.RedisClient.Del(ctx, key)
+
+	require.NoError(t, SetDeviceOnline(ctx, device))
+	ok, err := RefreshDeviceOnline(ctx, device.ID)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// 离线后保留在线信息直至过期，但不能再通过心跳续期
+	require.NoError(t, SetDeviceOffline(ctx, device.UserID, device.ID))
+	ttl, err := redisPkg.RedisClient.TTL(ctx, key).Result()
+	require.NoError(t, err)
+	assert.Positive(t, ttl)
+	ok, err = RefreshDeviceOnline(ctx, device.ID)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	redisPkg.RedisClient.Del(ctx, key)
+	ok, err = RefreshDeviceOnline(ctx, device.ID)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,456 @@
+package group
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/grouppb"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 成员类型，对应 group_user.member_type（1：管理员；2：普通成员；3：群主）
+const (
+	memberTypeOwner  = int8(grouppb.MemberType_MEMBER_TYPE_OWNER)
+	memberTypeAdmin  = int8(grouppb.MemberType_MEMBER_TYPE_ADMIN)
+	memberTypeMember = int8(grouppb.MemberType_MEMBER_TYPE_MEMBER)
+)
+
+// memberRank 各成员类型的权限等级，数值越大权限越高；未知类型等级为 0，不具备任何管理权限
+var memberRank = map[int8]int{
+	memberTypeMember: 1,
+	memberTypeAdmin:  2,
+	memberTypeOwner:  3,
+}
+
+// GroupExtService 群组服务
+type GroupExtService struct {
+	grouppb.UnimplementedGroupExtServiceServer
+	db         *sql.DB // 用于开启事务，为空时不使用事务
+	queries    dao.Querier
+	messageInt messagepb.MessageIntServiceClient // 用于向群内广播系统消息
+}
+
+// NewGroupExtService 创建一个新的 GroupExtService 实例
+func NewGroupExtService(db *sql.DB, queries dao.Querier, messageInt messagepb.MessageIntServiceClient) *GroupExtService {
+	return &GroupExtService{
+		db:         db,
+		queries:    queries,
+		messageInt: messageInt,
+	}
+}
+
+// CreateGroup 创建群组，创建者成为群主，初始成员须为创建者的好友
+func (s *GroupExtService) CreateGroup(ctx context.Context, req *grouppb.CreateGroupRequest) (*grouppb.CreateGroupReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	memberIDs := make([]uint64, 0, len(req.MemberIds))
+	for _, id := range req.MemberIds {
+		if id != userID {
+			memberIDs = append(memberIDs, id)
+		}
+	}
+	if err := s.checkFriends(ctx, userID, memberIDs); err != nil {
+		return nil, err
+	}
+
+	// 群组与成员在同一事务中写入，避免出现没有群主的群
+	now := time.Now()
+	var groupID uint64
+	err := s.inTx(ctx, func(q dao.Querier) error {
+		result, err := q.CreateGroup(ctx, dao.CreateGroupParams{
+			CreatedAt:    now,
+			UpdatedAt:    now,
+			Name:         req.Name,
+			AvatarUrl:    req.AvatarUrl,
+			Introduction: req.Introduction,
+			UserNum:      int32(len(memberIDs) + 1),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create group: %v", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get group id: %v", err)
+		}
+		groupID = uint64(id)
+
+		if err := addMember(ctx, q, groupID, userID, memberTypeOwner, now); err != nil {
+			return err
+		}
+		for _, memberID := range memberIDs {
+			if err := addMember(ctx, q, groupID, memberID, memberTypeMember, now); err != nil {
+				return err
+			}
+		}
+		return syncUserNum(ctx, q, groupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &grouppb.CreateGroupReply{GroupId: groupID}, nil
+}
+
+// InviteGroupMembers 邀请好友入群，任意成员均可邀请自己的好友，已在群内的用户会被忽略
+func (s *GroupExtService) InviteGroupMembers(ctx context.Context, req *grouppb.InviteGroupMembersRequest) (*grouppb.InviteGroupMembersReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if _, err := s.getMember(ctx, req.GroupId, userID); err != nil {
+		return nil, err
+	}
+
+	// 过滤掉已在群内的用户
+	newIDs := make([]uint64, 0, len(req.UserIds))
+	for _, id := range req.UserIds {
+		_, err := s.queries.GetGroupUser(ctx, dao.GetGroupUserParams{GroupID: req.GroupId, UserID: id})
+		if err == nil {
+			continue
+		}
+		if err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "failed to get group user: %v", err)
+		}
+		newIDs = append(newIDs, id)
+	}
+	if err := s.checkFriends(ctx, userID, newIDs); err != nil {
+		return nil, err
+	}
+
+	if len(newIDs) > 0 {
+		// 成员与群人数在同一事务中写入，避免 user_num 与成员表不一致
+		now := time.Now()
+		err := s.inTx(ctx, func(q dao.Querier) error {
+			for _, id := range newIDs {
+				if err := addMember(ctx, q, req.GroupId, id, memberTypeMember, now); err != nil {
+					return err
+				}
+			}
+			return syncUserNum(ctx, q, req.GroupId)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &grouppb.InviteGroupMembersReply{AddedUserIds: newIDs}, nil
+}
+
+// LeaveGroup 退出群组，群主不能直接退出，需先解散群组
+func (s *GroupExtService) LeaveGroup(ctx context.Context, req *grouppb.LeaveGroupRequest) (*grouppb.LeaveGroupReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	member, err := s.getMember(ctx, req.GroupId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member.MemberType == memberTypeOwner {
+		return nil, status.Error(codes.FailedPrecondition, "owner cannot leave group, dissolve it instead")
+	}
+
+	if err := s.removeMember(ctx, req.GroupId, userID); err != nil {
+		return nil, err
+	}
+	return &grouppb.LeaveGroupReply{}, nil
+}
+
+// DissolveGroup 解散群组（仅群主），同时清理成员关系与群会话视图
+func (s *GroupExtService) DissolveGroup(ctx context.Context, req *grouppb.DissolveGroupRequest) (*grouppb.DissolveGroupReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	member, err := s.getMember(ctx, req.GroupId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member.MemberType != memberTypeOwner {
+		return nil, status.Error(codes.PermissionDenied, "only owner can dissolve group")
+	}
+
+	// 成员、会话视图与群组在同一事务中删除，避免留下无人可管理的空群
+	err = s.inTx(ctx, func(q dao.Querier) error {
+		if err := q.DeleteGroupUsers(ctx, req.GroupId); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete group users: %v", err)
+		}
+		if err := q.DeleteConversationUsers(ctx, conversationID(req.GroupId)); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete group conversations: %v", err)
+		}
+		if err := q.DeleteGroup(ctx, req.GroupId); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete group: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &grouppb.DissolveGroupReply{}, nil
+}
+
+// KickGroupMember 移出群成员：群主可移出管理员与普通成员，管理员仅可移出普通成员
+func (s *GroupExtService) KickGroupMember(ctx context.Context, req *grouppb.KickGroupMemberRequest) (*grouppb.KickGroupMemberReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if userID == req.UserId {
+		return nil, status.Error(codes.InvalidArgument, "cannot kick yourself, leave group instead")
+	}
+
+	if err := s.requireOutranks(ctx, req.GroupId, userID, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if err := s.removeMember(ctx, req.GroupId, req.UserId); err != nil {
+		return nil, err
+	}
+	return &grouppb.KickGroupMemberReply{}, nil
+}
+
+// SetGroupAdmin 设置/取消管理员（仅群主）
+func (s *GroupExtService) SetGroupAdmin(ctx context.Context, req *grouppb.SetGroupAdminRequest) (*grouppb.SetGroupAdminReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	operator, err := s.getMember(ctx, req.GroupId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if operator.MemberType != memberTypeOwner {
+		return nil, status.Error(codes.PermissionDenied, "only owner can set admins")
+	}
+	target, err := s.getTarget(ctx, req.GroupId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if target.MemberType == memberTypeOwner {
+		return nil, status.Error(codes.InvalidArgument, "cannot change owner's role")
+	}
+
+	memberType := memberTypeMember
+	if req.IsAdmin {
+		memberType = memberTypeAdmin
+	}
+	if target.MemberType != memberType {
+		if err := s.queries.UpdateGroupUserType(ctx, dao.UpdateGroupUserTypeParams{
+			UpdatedAt:  time.Now(),
+			MemberType: memberType,
+			GroupID:    req.GroupId,
+			UserID:     req.UserId,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update member type: %v", err)
+		}
+	}
+	return &grouppb.SetGroupAdminReply{MemberType: grouppb.MemberType(memberType)}, nil
+}
+
+// ListGroupMembers 获取群成员列表（仅群成员可查看）
+func (s *GroupExtService) ListGroupMembers(ctx context.Context, req *grouppb.ListGroupMembersRequest) (*grouppb.ListGroupMembersReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if _, err := s.getMember(ctx, req.GroupId, userID); err != nil {
+		return nil, err
+	}
+
+	groupUsers, err := s.queries.GetGroupUsers(ctx, req.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group users: %v", err)
+	}
+	ids := make([]uint64, len(groupUsers))
+	for i, gu := range groupUsers {
+		ids[i] = gu.UserID
+	}
+	users, err := s.queries.ListUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get users: %v", err)
+	}
+	profiles := make(map[uint64]dao.ListUsersByIDsRow, len(users))
+	for _, u := range users {
+		profiles[u.ID] = u
+	}
+
+	members := make([]*grouppb.GroupMemberInfo, len(groupUsers))
+	for i, gu := range groupUsers {
+		p := profiles[gu.UserID]
+		members[i] = &grouppb.GroupMemberInfo{
+			UserId:     gu.UserID,
+			Username:   p.Username,
+			Nickname:   p.Nickname,
+			AvatarUrl:  p.AvatarUrl,
+			MemberType: grouppb.MemberType(gu.MemberType),
+			Remarks:    gu.Remarks,
+			JoinedAt:   gu.CreatedAt.Unix(),
+		}
+		members[i].Muted, members[i].MutedUntil = muteState(gu.MutedUntil)
+	}
+	return &grouppb.ListGroupMembersReply{Members: members}, nil
+}
+
+// ListMyGroups 获取当前用户加入的群组
+func (s *GroupExtService) ListMyGroups(ctx context.Context, req *grouppb.ListMyGroupsRequest) (*grouppb.ListMyGroupsReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	memberships, err := s.queries.GetUserGroups(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user groups: %v", err)
+	}
+	if len(memberships) == 0 {
+		return &grouppb.ListMyGroupsReply{Groups: []*grouppb.GroupInfo{}}, nil
+	}
+	ids := make([]uint64, len(memberships))
+	for i, m := range memberships {
+		ids[i] = m.GroupID
+	}
+	rows, err := s.queries.ListGroupsByIDs(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get groups: %v", err)
+	}
+	groups := make(map[uint64]dao.Group, len(rows))
+	for _, g := range rows {
+		groups[g.ID] = g
+	}
+
+	infos := make([]*grouppb.GroupInfo, 0, len(memberships))
+	for _, m := range memberships {
+		g, ok := groups[m.GroupID]
+		if !ok {
+			continue
+		}
+		info := &grouppb.GroupInfo{
+			GroupId:      g.ID,
+			Name:         g.Name,
+			AvatarUrl:    g.AvatarUrl,
+			Introduction: g.Introduction,
+			UserNum:      g.UserNum,
+			MemberType:   grouppb.MemberType(m.MemberType),
+			CreatedAt:    g.CreatedAt.Unix(),
+		}
+		info.MuteAll, info.MuteAllUntil = muteState(g.MuteAllUntil)
+		infos = append(infos, info)
+	}
+	return &grouppb.ListMyGroupsReply{Groups: infos}, nil
+}
+
+// getMember 获取操作者在群内的成员信息，非成员返回 PermissionDenied
+func (s *GroupExtService) getMember(ctx context.Context, groupID, userID uint64) (*dao.GroupUser, error) {
+	member, err := s.queries.GetGroupUser(ctx, dao.GetGroupUserParams{GroupID: groupID, UserID: userID})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.PermissionDenied, "not a group member")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get group user: %v", err)
+	}
+	return &member, nil
+}
+
+// getTarget 获取被操作成员的信息，不在群内返回 NotFound
+func (s *GroupExtService) getTarget(ctx context.Context, groupID, userID uint64) (*dao.GroupUser, error) {
+	target, err := s.queries.GetGroupUser(ctx, dao.GetGroupUserParams{GroupID: groupID, UserID: userID})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "group member not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get group user: %v", err)
+	}
+	return &target, nil
+}
+
+// checkFriends 校验 ids 均为 userID 的好友
+func (s *GroupExtService) checkFriends(ctx context.Context, userID uint64, ids []uint64) error {
+	for _, id := range ids {
+		count, err := s.queries.CheckFriendship(ctx, dao.CheckFriendshipParams{UserID: userID, FriendID: id})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check friendship: %v", err)
+		}
+		if count == 0 {
+			return status.Errorf(codes.FailedPrecondition, "user %d is not your friend", id)
+		}
+	}
+	return nil
+}
+
+// addMember 写入一条群成员记录
+func addMember(ctx context.Context, q dao.Querier, groupID, userID uint64, memberType int8, now time.Time) error {
+	if err := q.CreateGroupUser(ctx, dao.CreateGroupUserParams{
+		GroupID:    groupID,
+		UserID:     userID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		MemberType: memberType,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to add group user: %v", err)
+	}
+	return nil
+}
+
+// removeMember 在同一事务中移除群成员、清理其群会话视图并同步群人数
+func (s *GroupExtService) removeMember(ctx context.Context, groupID, userID uint64) error {
+	return s.inTx(ctx, func(q dao.Querier) error {
+		if err := q.DeleteGroupUser(ctx, dao.DeleteGroupUserParams{GroupID: groupID, UserID: userID}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete group user: %v", err)
+		}
+		if err := q.DeleteUserConversation(ctx, dao.DeleteUserConversationParams{
+			UserID:         userID,
+			ConversationID: conversationID(groupID),
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete user conversation: %v", err)
+		}
+		return syncUserNum(ctx, q, groupID)
+	})
+}
+
+// syncUserNum 按 group_user 实际行数回写 group.user_num
+func syncUserNum(ctx context.Context, q dao.Querier, groupID uint64) error {
+	if err := q.SyncGroupUserNum(ctx, dao.SyncGroupUserNumParams{UpdatedAt: time.Now(), ID: groupID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to sync user num: %v", err)
+	}
+	return nil
+}
+
+// inTx 在事务中执行 fn，fn 返回错误时回滚。
+// 未配置数据库连接或 Querier 不是 *dao.Queries（如单测中的 mock）时直接以 s.queries 执行
+func (s *GroupExtService) inTx(ctx context.Context, fn func(q dao.Querier) error) error {
+	queries, ok := s.queries.(*dao.Queries)
+	if !ok || s.db == nil {
+		return fn(s.queries)
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	if err := fn(queries.WithTx(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return nil
+}
+
+// conversationID 群聊会话ID，与消息服务约定一致（g_<groupId>）
+func conversationID(groupID uint64) string {
+	return fmt.Sprintf("g_%d", groupID)
+}
@@ -0,0 +1,268 @@
+package group
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"im-server/pkg/dao"
+	mock_dao "im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/grouppb"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockResult 是一个 sql.Result 的简单模拟实现
+type mockResult int64
+
+func (m mockResult) LastInsertId() (int64, error) {
+	return int64(m), nil
+}
+
+func (m mockResult) RowsAffected() (int64, error) {
+	return 1, nil
+}
+
+// fakeMessageInt 记录发送的群系统消息
+type fakeMessageInt struct {
+	messagepb.MessageIntServiceClient
+	sent []*messagepb.SendGroupSystemMessageRequest
+}
+
+func (f *fakeMessageInt) SendGroupSystemMessage(ctx context.Context, in *messagepb.SendGroupSystemMessageRequest, opts ...grpc.CallOption) (*messagepb.SendMessageReply, error) {
+	f.sent = append(f.sent, in)
+	return &messagepb.SendMessageReply{}, nil
+}
+
+// 测试CreateGroup接口
+func TestCreateGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	service := NewGroupExtService(nil, queries, nil)
+
+	t.Run("创建者成为群主，好友成为普通成员", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "user_id", uint64(1))
+		req := &grouppb.CreateGroupRequest{Name: "测试群", MemberIds: []uint64{1, 2}}
+
+		queries.EXPECT().
+			CheckFriendship(gomock.Any(), dao.CheckFriendshipParams{UserID: 1, FriendID: 2}).
+			Return(int64(1), nil)
+		queries.EXPECT().CreateGroup(gomock.Any(), gomock.Any()).Return(mockResult(10000), nil)
+		queries.EXPECT().
+			CreateGroupUser(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dao.CreateGroupUserParams) error {
+				assert.Equal(t, uint64(1), arg.UserID)
+				assert.Equal(t, memberTypeOwner, arg.MemberType)
+				return nil
+			})
+		queries.EXPECT().
+			CreateGroupUser(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dao.CreateGroupUserParams) error {
+				assert.Equal(t, uint64(2), arg.UserID)
+				assert.Equal(t, memberTypeMember, arg.MemberType)
+				return nil
+			})
+		queries.EXPECT().SyncGroupUserNum(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := service.CreateGroup(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10000), resp.GroupId)
+	})
+
+	t.Run("邀请非好友应该失败", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "user_id", uint64(1))
+		req := &grouppb.CreateGroupRequest{Name: "测试群", MemberIds: []uint64{3}}
+
+		queries.EXPECT().
+			CheckFriendship(gomock.Any(), dao.CheckFriendshipParams{UserID: 1, FriendID: 3}).
+			Return(int64(0), nil)
+
+		resp, err := service.CreateGroup(ctx, req)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+// 测试KickGroupMember接口的权限控制
+func TestKickGroupMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	service := NewGroupExtService(nil, queries, nil)
+	ctx := context.WithValue(context.Background(), "user_id", uint64(1))
+
+	expectMember := func(userID uint64, memberType int8) {
+		queries.EXPECT().
+			GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 100, UserID: userID}).
+			Return(dao.GroupUser{GroupID: 100, UserID: userID, MemberType: memberType}, nil)
+	}
+
+	t.Run("群主移出管理员", func(t *testing.T) {
+		expectMember(1, memberTypeOwner)
+		expectMember(2, memberTypeAdmin)
+		queries.EXPECT().DeleteGroupUser(gomock.Any(), dao.DeleteGroupUserParams{GroupID: 100, UserID: 2}).Return(nil)
+		queries.EXPECT().
+			DeleteUserConversation(gomock.Any(), dao.DeleteUserConversationParams{UserID: 2, ConversationID: "g_100"}).
+			Return(nil)
+		queries.EXPECT().SyncGroupUserNum(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := service.KickGroupMember(ctx, &grouppb.KickGroupMemberRequest{GroupId: 100, UserId: 2})
+		require.NoError(t, err)
+	})
+
+	t.Run("管理员不能移出管理员", func(t *testing.T) {
+		expectMember(1, memberTypeAdmin)
+		expectMember(2, memberTypeAdmin)
+
+		_, err := service.KickGroupMember(ctx, &grouppb.KickGroupMemberRequest{GroupId: 100, UserId: 2})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("普通成员不能移出他人", func(t *testing.T) {
+		expectMember(1, memberTypeMember)
+		expectMember(2, memberTypeMember)
+
+		_, err := service.KickGroupMember(ctx, &grouppb.KickGroupMemberRequest{GroupId: 100, UserId: 2})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("未指定成员类型不具备管理权限", func(t *testing.T) {
+		expectMember(1, 0)
+		expectMember(2, memberTypeMember)
+
+		_, err := service.KickGroupMember(ctx, &grouppb.KickGroupMemberRequest{GroupId: 100, UserId: 2})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("管理员不能移出群主", func(t *testing.T) {
+		expectMember(1, memberTypeAdmin)
+		expectMember(2, memberTypeOwner)
+
+		_, err := service.KickGroupMember(ctx, &grouppb.KickGroupMemberRequest{GroupId: 100, UserId: 2})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("非群成员操作应该失败", func(t *testing.T) {
+		queries.EXPECT().
+			GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 100, UserID: 1}).
+			Return(dao.GroupUser{}, sql.ErrNoRows)
+
+		_, err := service.KickGroupMember(ctx, &grouppb.KickGroupMemberRequest{GroupId: 100, UserId: 2})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+// 测试SetGroupAdmin与LeaveGroup的群主限制
+func TestOwnerRestrictions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	service := NewGroupExtService(nil, queries, nil)
+	ctx := context.WithValue(context.Background(), "user_id", uint64(1))
+
+	t.Run("群主设置管理员", func(t *testing.T) {
+		queries.EXPECT().
+			GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 100, UserID: 1}).
+			Return(dao.GroupUser{MemberType: memberTypeOwner}, nil)
+		queries.EXPECT().
+			GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 100, UserID: 2}).
+			Return(dao.GroupUser{MemberType: memberTypeMember}, nil)
+		queries.EXPECT().
+			UpdateGroupUserType(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dao.UpdateGroupUserTypeParams) error {
+				assert.Equal(t, memberTypeAdmin, arg.MemberType)
+				return nil
+			})
+
+		resp, err := service.SetGroupAdmin(ctx, &grouppb.SetGroupAdminRequest{GroupId: 100, UserId: 2, IsAdmin: true})
+		require.NoError(t, err)
+		assert.Equal(t, grouppb.MemberType_MEMBER_TYPE_ADMIN, resp.MemberType)
+	})
+
+	t.Run("管理员不能设置管理员", func(t *testing.T) {
+		queries.EXPECT().
+			GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 100, UserID: 1}).
+			Return(dao.GroupUser{MemberType: memberTypeAdmin}, nil)
+
+		_, err := service.SetGroupAdmin(ctx, &grouppb.SetGroupAdminRequest{GroupId: 100, UserId: 2, IsAdmin: true})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("群主不能直接退群", func(t *testing.T) {
+		queries.EXPECT().
+			GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 100, UserID: 1}).
+			Return(dao.GroupUser{MemberType: memberTypeOwner}, nil)
+
+		_, err := service.LeaveGroup(ctx, &grouppb.LeaveGroupRequest{GroupId: 100})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("未认证用户应该失败", func(t *testing.T) {
+		_, err := service.DissolveGroup(context.Background(), &grouppb.DissolveGroupRequest{GroupId: 100})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+// 测试群禁言接口
+func TestMuteGroupMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	notifier := &fakeMessageInt{}
+	service := NewGroupExtService(nil, queries, notifier)
+	ctx := context.WithValue(context.Background(), "user_id", uint64(1))
+
+	t.Run("管理员禁言普通成员并广播系统消息", func(t *testing.T) {
+		queries.EXPECT().
+			GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 100, UserID: 1}).
+			Return(dao.GroupUser{MemberType: memberTypeAdmin}, nil)
+		queries.EXPECT().
+			GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 100, UserID: 2}).
+			Return(dao.GroupUser{MemberType: memberTypeMember}, nil)
+		queries.EXPECT().
+			SetGroupUserMute(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dao.SetGroupUserMuteParams) error {
+				assert.True(t, arg.MutedUntil.Valid)
+				assert.Equal(t, uint64(2), arg.UserID)
+				return nil
+			})
+
+		resp, err := service.MuteGroupMember(ctx, &grouppb.MuteGroupMemberRequest{GroupId: 100, UserId: 2, DurationSeconds: 600})
+		require.NoError(t, err)
+		assert.Greater(t, resp.MuteUntil, int64(0))
+		require.Len(t, notifier.sent, 1)
+		assert.Equal(t, messagepb.SystemNoticeType_SYSTEM_NOTICE_GROUP_MEMBER_MUTE, notifier.sent[0].Content.Type)
+		assert.Equal(t, []uint64{2}, notifier.sent[0].Content.TargetIds)
+	})
+
+	t.Run("普通成员不能开启全员禁言", func(t *testing.T) {
+		queries.EXPECT().
+			GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 100, UserID: 1}).
+			Return(dao.GroupUser{MemberType: memberTypeMember}, nil)
+
+		_, err := service.MuteGroup(ctx, &grouppb.MuteGroupRequest{GroupId: 100})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("永久禁言返回截止时间为0", func(t *testing.T) {
+		queries.EXPECT().
+			GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 100, UserID: 1}).
+			Return(dao.GroupUser{MemberType: memberTypeOwner}, nil)
+		queries.EXPECT().SetGroupMuteAll(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := service.MuteGroup(ctx, &grouppb.MuteGroupRequest{GroupId: 100})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), resp.MuteUntil)
+	})
+}
@@ -0,0 +1,195 @@
+package group
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/grouppb"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// muteForever 未指定时长时的禁言截止时间，表示直到手动解除
+var muteForever = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// MuteGroup 开启全员禁言（群主/管理员）
+func (s *GroupExtService) MuteGroup(ctx context.Context, req *grouppb.MuteGroupRequest) (*grouppb.MuteGroupReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.requireManager(ctx, req.GroupId, userID); err != nil {
+		return nil, err
+	}
+
+	until := muteUntil(req.DurationSeconds)
+	if err := s.queries.SetGroupMuteAll(ctx, dao.SetGroupMuteAllParams{
+		UpdatedAt:    time.Now(),
+		MuteAllUntil: sql.NullTime{Time: until, Valid: true},
+		ID:           req.GroupId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mute group: %v", err)
+	}
+
+	_, untilUnix := muteState(sql.NullTime{Time: until, Valid: true})
+	s.broadcast(ctx, req.GroupId, &messagepb.SystemContent{
+		Type:       messagepb.SystemNoticeType_SYSTEM_NOTICE_GROUP_MUTE_ALL,
+		OperatorId: userID,
+		Until:      untilUnix,
+		Text:       "已开启全员禁言",
+	})
+	return &grouppb.MuteGroupReply{MuteUntil: untilUnix}, nil
+}
+
+// UnmuteGroup 解除全员禁言（群主/管理员）
+func (s *GroupExtService) UnmuteGroup(ctx context.Context, req *grouppb.UnmuteGroupRequest) (*grouppb.UnmuteGroupReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.requireManager(ctx, req.GroupId, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.queries.SetGroupMuteAll(ctx, dao.SetGroupMuteAllParams{
+		UpdatedAt: time.Now(),
+		ID:        req.GroupId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmute group: %v", err)
+	}
+
+	s.broadcast(ctx, req.GroupId, &messagepb.SystemContent{
+		Type:       messagepb.SystemNoticeType_SYSTEM_NOTICE_GROUP_UNMUTE_ALL,
+		OperatorId: userID,
+		Text:       "已解除全员禁言",
+	})
+	return &grouppb.UnmuteGroupReply{}, nil
+}
+
+// MuteGroupMember 禁言群成员：群主可禁言管理员与普通成员，管理员仅可禁言普通成员
+func (s *GroupExtService) MuteGroupMember(ctx context.Context, req *grouppb.MuteGroupMemberRequest) (*grouppb.MuteGroupMemberReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.requireOutranks(ctx, req.GroupId, userID, req.UserId); err != nil {
+		return nil, err
+	}
+
+	until := muteUntil(req.DurationSeconds)
+	if err := s.queries.SetGroupUserMute(ctx, dao.SetGroupUserMuteParams{
+		UpdatedAt:  time.Now(),
+		MutedUntil: sql.NullTime{Time: until, Valid: true},
+		GroupID:    req.GroupId,
+		UserID:     req.UserId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mute member: %v", err)
+	}
+
+	_, untilUnix := muteState(sql.NullTime{Time: until, Valid: true})
+	s.broadcast(ctx, req.GroupId, &messagepb.SystemContent{
+		Type:       messagepb.SystemNoticeType_SYSTEM_NOTICE_GROUP_MEMBER_MUTE,
+		OperatorId: userID,
+		TargetIds:  []uint64{req.UserId},
+		Until:      untilUnix,
+		Text:       fmt.Sprintf("用户 %d 已被禁言", req.UserId),
+	})
+	return &grouppb.MuteGroupMemberReply{MuteUntil: untilUnix}, nil
+}
+
+// UnmuteGroupMember 解除群成员禁言，权限规则与禁言一致
+func (s *GroupExtService) UnmuteGroupMember(ctx context.Context, req *grouppb.UnmuteGroupMemberRequest) (*grouppb.UnmuteGroupMemberReply, error) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.requireOutranks(ctx, req.GroupId, userID, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if err := s.queries.SetGroupUserMute(ctx, dao.SetGroupUserMuteParams{
+		UpdatedAt: time.Now(),
+		GroupID:   req.GroupId,
+		UserID:    req.UserId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmute member: %v", err)
+	}
+
+	s.broadcast(ctx, req.GroupId, &messagepb.SystemContent{
+		Type:       messagepb.SystemNoticeType_SYSTEM_NOTICE_GROUP_MEMBER_UNMUTE,
+		OperatorId: userID,
+		TargetIds:  []uint64{req.UserId},
+		Text:       fmt.Sprintf("用户 %d 已被解除禁言", req.UserId),
+	})
+	return &grouppb.UnmuteGroupMemberReply{}, nil
+}
+
+// requireManager 校验操作者为群主或管理员
+func (s *GroupExtService) requireManager(ctx context.Context, groupID, userID uint64) error {
+	operator, err := s.getMember(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if memberRank[operator.MemberType] < memberRank[memberTypeAdmin] {
+		return status.Error(codes.PermissionDenied, "only owner or admin can do this")
+	}
+	return nil
+}
+
+// requireOutranks 校验操作者为群主/管理员，且角色严格高于目标成员
+func (s *GroupExtService) requireOutranks(ctx context.Context, groupID, operatorID, targetID uint64) error {
+	if operatorID == targetID {
+		return status.Error(codes.InvalidArgument, "cannot operate on yourself")
+	}
+	operator, err := s.getMember(ctx, groupID, operatorID)
+	if err != nil {
+		return err
+	}
+	target, err := s.getTarget(ctx, groupID, targetID)
+	if err != nil {
+		return err
+	}
+	rank := memberRank[operator.MemberType]
+	if rank < memberRank[memberTypeAdmin] || memberRank[target.MemberType] >= rank {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// broadcast 以系统消息向群内广播变更；失败只记录日志，不影响操作结果
+func (s *GroupExtService) broadcast(ctx context.Context, groupID uint64, content *messagepb.SystemContent) {
+	if s.messageInt == nil {
+		return
+	}
+	if _, err := s.messageInt.SendGroupSystemMessage(ctx, &messagepb.SendGroupSystemMessageRequest{
+		GroupId: groupID,
+		Content: content,
+	}); err != nil {
+		log.Printf("broadcast group %d system message failed: %v", groupID, err)
+	}
+}
+
+// muteUntil 根据时长计算禁言截止时间，0 表示直到手动解除
+func muteUntil(durationSeconds int64) time.Time {
+	if durationSeconds == 0 {
+		return muteForever
+	}
+	return time.Now().Add(time.Duration(durationSeconds) * time.Second)
+}
+
+// muteState 将截止时间转换为（是否禁言中, 截止时间戳），截止时间戳 0 表示直到手动解除
+func muteState(until sql.NullTime) (bool, int64) {
+	if !until.Valid || !until.Time.After(time.Now()) {
+		return false, 0
+	}
+	if !until.Time.Before(muteForever) {
+		return true, 0
+	}
+	return true, until.Time.Unix()
+}
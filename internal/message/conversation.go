@@ -0,0 +1,205 @@
+package message
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultConversationLimit = 20
+	maxPinnedConversations   = 100
+)
+
+// firstPageCursor 首页游标，晚于任何会话的 updated_at
+var firstPageCursor = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// ListConversations 获取当前用户的会话列表：首页先返回置顶会话，其余按 (updated_at, conversation_id) 键集分页
+func (s *MessageExtService) ListConversations(ctx context.Context, req *messagepb.ListConversationsRequest) (*messagepb.ListConversationsReply, error) {
+	uid, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := int32(req.Limit)
+	if limit == 0 {
+		limit = defaultConversationLimit
+	}
+
+	firstPage := req.CursorUpdatedAt == 0 && req.CursorConversationId == ""
+	if !firstPage && (req.CursorUpdatedAt == 0 || req.CursorConversationId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor_updated_at and cursor_conversation_id must be set together")
+	}
+
+	var (
+		rows            []dao.ListUserConversationsRow
+		pinnedTruncated bool
+	)
+	cursorAt := time.UnixMilli(req.CursorUpdatedAt)
+	if firstPage {
+		// 1. 首页：置顶会话整体排在最前，不参与分页；超过上限时只返回最近活跃的部分并标记截断
+		pinned, err := s.queries.ListPinnedUserConversations(ctx, dao.ListPinnedUserConversationsParams{
+			UserID: uid,
+			Limit:  maxPinnedConversations + 1,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "list pinned conversations: %v", err)
+		}
+		if len(pinned) > maxPinnedConversations {
+			pinned = pinned[:maxPinnedConversations]
+			pinnedTruncated = true
+		}
+		for _, r := range pinned {
+			rows = append(rows, dao.ListUserConversationsRow(r))
+		}
+		cursorAt = firstPageCursor
+	}
+
+	// 2. 非置顶会话按键集分页（多取一条用于判断 has_more）
+	unpinned, err := s.queries.ListUserConversations(ctx, dao.ListUserConversationsParams{
+		UserID:               uid,
+		CursorUpdatedAt:      cursorAt,
+		CursorConversationID: req.CursorConversationId,
+		Limit:                limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list conversations: %v", err)
+	}
+	hasMore := len(unpinned) > int(limit)
+	if hasMore {
+		unpinned = unpinned[:limit]
+	}
+	rows = append(rows, unpinned...)
+
+	// 3. 补全最后一条消息摘要与对端资料
+	conversations, err := s.buildConversationInfos(ctx, uid, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &messagepb.ListConversationsReply{
+		Conversations:   conversations,
+		HasMore:         hasMore,
+		PinnedTruncated: pinnedTruncated,
+	}
+	if n := len(unpinned); n > 0 {
+		reply.NextCursorUpdatedAt = unpinned[n-1].UpdatedAt.UnixMilli()
+		reply.NextCursorConversationId = unpinned[n-1].ConversationID
+	}
+	return reply, nil
+}
+
+// buildConversationInfos 将会话行组装为 ConversationInfo，批量读取消息摘要（Mongo）与对端/群组资料（MySQL）
+func (s *MessageExtService) buildConversationInfos(ctx context.Context, uid uint64, rows []dao.ListUserConversationsRow) ([]*messagepb.ConversationInfo, error) {
+	msgIDs := make([]string, 0, len(rows))
+	peerIDs := make([]uint64, 0, len(rows))
+	peerOf := make(map[string]uint64, len(rows))
+	groupIDs := make([]uint64, 0, len(rows))
+	for _, r := range rows {
+		if r.LastMessageID.Valid {
+			msgIDs = append(msgIDs, r.LastMessageID.String)
+		}
+		switch r.Type {
+		case convTypeP2P:
+			if peer, ok := p2pPeer(r.Participants, uid); ok {
+				peerOf[r.ConversationID] = peer
+				peerIDs = append(peerIDs, peer)
+			}
+		case convTypeGroup:
+			if gid, ok := parseGroupConvID(r.ConversationID); ok {
+				groupIDs = append(groupIDs, gid)
+			}
+		}
+	}
+
+	bodies, err := s.mongo.GetMessageBodies(ctx, msgIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load message bodies: %v", err)
+	}
+	peers := make(map[uint64]*messagepb.PeerInfo, len(peerIDs))
+	if len(peerIDs) > 0 {
+		users, err := s.queries.ListUsersByIDs(ctx, peerIDs)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load peers: %v", err)
+		}
+		for _, u := range users {
+			peers[u.ID] = &messagepb.PeerInfo{
+				UserId:    u.ID,
+				Username:  u.Username,
+				Nickname:  u.Nickname,
+				AvatarUrl: u.AvatarUrl,
+			}
+		}
+	}
+
+	groups := make(map[uint64]*messagepb.GroupBrief, len(groupIDs))
+	if len(groupIDs) > 0 {
+		rowsGroups, err := s.queries.ListGroupsByIDs(ctx, groupIDs)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load groups: %v", err)
+		}
+		for _, g := range rowsGroups {
+			groups[g.ID] = &messagepb.GroupBrief{
+				GroupId:   g.ID,
+				Name:      g.Name,
+				AvatarUrl: g.AvatarUrl,
+				UserNum:   g.UserNum,
+			}
+		}
+	}
+
+	conversations := make([]*messagepb.ConversationInfo, len(rows))
+	for i, r := range rows {
+		info := &messagepb.ConversationInfo{
+			ConversationId:        r.ConversationID,
+			Type:                  int32(r.Type),
+			LastMessageId:         r.LastMessageID.String,
+			LastSeq:               r.LastSeq.Int64,
+			UnreadCount:           r.UnreadCount.Int32,
+			LastReadSeq:           r.LastReadSeq.Int64,
+			IsPinned:              r.IsPinned.Bool,
+			IsMuted:               r.IsMuted.Bool,
+			UpdatedAt:             r.UpdatedAt.UnixMilli(),
+			MentionCount:          r.MentionCount,
+			FirstUnreadMentionSeq: r.FirstUnreadMentionSeq,
+		}
+		if body, ok := bodies[r.LastMessageID.String]; ok {
+			if body.Recalled {
+				info.LastMessagePreview = recalledPreview
+			} else {
+				info.LastMessagePreview = previewOf(decodeContent(body.Body))
+			}
+		}
+		if peer, ok := peerOf[r.ConversationID]; ok {
+			info.Peer = peers[peer]
+		}
+		if gid, ok := parseGroupConvID(r.ConversationID); ok && r.Type == convTypeGroup {
+			info.Group = groups[gid]
+		}
+		conversations[i] = info
+	}
+	return conversations, nil
+}
+
+// p2pPeer 从单聊参与者中找出对端用户（与自己的会话返回自己）
+func p2pPeer(participants json.RawMessage, uid uint64) (uint64, bool) {
+	var ids []uint64
+	if err := json.Unmarshal(participants, &ids); err != nil || len(ids) == 0 {
+		return 0, false
+	}
+	for _, id := range ids {
+		if id != uid {
+			return id, true
+		}
+	}
+	return uid, true
+}
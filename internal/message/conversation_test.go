@@ -0,0 +1,224 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"im-server/pkg/dao"
+	mock_dao "im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// p2pRow 生成 uid 与 peer 的单聊会话行
+func p2pRow(uid, peer uint64, updatedAt time.Time) dao.ListUserConversationsRow {
+	participants, _ := json.Marshal([]uint64{uid, peer})
+	return dao.ListUserConversationsRow{
+		ConversationID: buildP2PConvID(uid, peer),
+		Type:           convTypeP2P,
+		Participants:   participants,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+func conversationIDs(conversations []*messagepb.ConversationInfo) []string {
+	ids := make([]string, len(conversations))
+	for i, c := range conversations {
+		ids[i] = c.ConversationId
+	}
+	return ids
+}
+
+// 测试ListConversations接口
+func TestListConversations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	store := newFakeStore()
+	service := &MessageExtService{queries: queries, mongo: store}
+	ctx := userCtx(1)
+	base := time.UnixMilli(1700000000000)
+
+	t.Run("首页置顶会话在前并返回下一页游标", func(t *testing.T) {
+		pinnedParticipants, _ := json.Marshal([]uint64{1, 4})
+		queries.EXPECT().
+			ListPinnedUserConversations(gomock.Any(), dao.ListPinnedUserConversationsParams{UserID: 1, Limit: maxPinnedConversations + 1}).
+			Return([]dao.ListPinnedUserConversationsRow{{
+				ConversationID: "p_1_4",
+				Type:           convTypeP2P,
+				Participants:   pinnedParticipants,
+				IsPinned:       sql.NullBool{Bool: true, Valid: true},
+				UpdatedAt:      base.Add(-time.Hour),
+			}}, nil)
+		queries.EXPECT().
+			ListUserConversations(gomock.Any(), dao.ListUserConversationsParams{
+				UserID:          1,
+				CursorUpdatedAt: firstPageCursor,
+				Limit:           3,
+			}).
+			Return([]dao.ListUserConversationsRow{
+				p2pRow(1, 2, base),
+				p2pRow(1, 3, base.Add(-time.Second)),
+				p2pRow(1, 5, base.Add(-2*time.Second)),
+			}, nil)
+		queries.EXPECT().ListUsersByIDs(gomock.Any(), []uint64{4, 2, 3}).Return([]dao.ListUsersByIDsRow{
+			{ID: 2, Nickname: "bob"},
+			{ID: 3, Nickname: "carol"},
+			{ID: 4, Nickname: "dave"},
+		}, nil)
+
+		resp, err := service.ListConversations(ctx, &messagepb.ListConversationsRequest{Limit: 2})
+		require.NoError(t, err)
+		assert.True(t, resp.HasMore)
+		assert.Equal(t, []string{"p_1_4", "p_1_2", "p_1_3"}, conversationIDs(resp.Conversations))
+		assert.True(t, resp.Conversations[0].IsPinned)
+		assert.Equal(t, "dave", resp.Conversations[0].GetPeer().GetNickname())
+		// 游标取最后一个非置顶会话
+		assert.Equal(t, base.Add(-time.Second).UnixMilli(), resp.NextCursorUpdatedAt)
+		assert.Equal(t, "p_1_3", resp.NextCursorConversationId)
+		assert.False(t, resp.PinnedTruncated)
+	})
+
+	t.Run("置顶会话超过上限时截断并标记", func(t *testing.T) {
+		pinned := make([]dao.ListPinnedUserConversationsRow, maxPinnedConversations+1)
+		for i := range pinned {
+			pinned[i] = dao.ListPinnedUserConversationsRow{
+				ConversationID: fmt.Sprintf("g_%d", i+1),
+				Type:           convTypeGroup,
+				IsPinned:       sql.NullBool{Bool: true, Valid: true},
+				UpdatedAt:      base.Add(-time.Duration(i) * time.Second),
+			}
+		}
+		queries.EXPECT().ListPinnedUserConversations(gomock.Any(), gomock.Any()).Return(pinned, nil)
+		queries.EXPECT().ListUserConversations(gomock.Any(), gomock.Any()).Return(nil, nil)
+		queries.EXPECT().ListGroupsByIDs(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []uint64) ([]dao.Group, error) {
+				assert.Len(t, ids, maxPinnedConversations)
+				return nil, nil
+			})
+
+		resp, err := service.ListConversations(ctx, &messagepb.ListConversationsRequest{})
+		require.NoError(t, err)
+		assert.True(t, resp.PinnedTruncated)
+		assert.Len(t, resp.Conversations, maxPinnedConversations)
+		assert.Equal(t, fmt.Sprintf("g_%d", maxPinnedConversations), resp.Conversations[maxPinnedConversations-1].ConversationId)
+	})
+
+	t.Run("后续页按游标分页且不再返回置顶会话", func(t *testing.T) {
+		queries.EXPECT().
+			ListUserConversations(gomock.Any(), dao.ListUserConversationsParams{
+				UserID:               1,
+				CursorUpdatedAt:      base.Add(-time.Second),
+				CursorConversationID: "p_1_3",
+				Limit:                3,
+			}).
+			Return([]dao.ListUserConversationsRow{p2pRow(1, 5, base.Add(-2*time.Second))}, nil)
+		queries.EXPECT().ListUsersByIDs(gomock.Any(), []uint64{5}).Return(nil, nil)
+
+		resp, err := service.ListConversations(ctx, &messagepb.ListConversationsRequest{
+			CursorUpdatedAt:      base.Add(-time.Second).UnixMilli(),
+			CursorConversationId: "p_1_3",
+			Limit:                2,
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.HasMore)
+		assert.Equal(t, []string{"p_1_5"}, conversationIDs(resp.Conversations))
+		assert.Equal(t, "p_1_5", resp.NextCursorConversationId)
+	})
+
+	t.Run("最后一页之后返回空页且不带游标", func(t *testing.T) {
+		queries.EXPECT().ListUserConversations(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		resp, err := service.ListConversations(ctx, &messagepb.ListConversationsRequest{
+			CursorUpdatedAt:      base.Add(-2 * time.Second).UnixMilli(),
+			CursorConversationId: "p_1_5",
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.HasMore)
+		assert.Empty(t, resp.Conversations)
+		assert.Zero(t, resp.NextCursorUpdatedAt)
+		assert.Empty(t, resp.NextCursorConversationId)
+	})
+
+	t.Run("补全消息摘要与群组资料", func(t *testing.T) {
+		store.putText("m1", "hi there")
+		store.putText("m2", "oops")
+		store.bodies["m2"].Recalled = true
+
+		group := dao.ListUserConversationsRow{
+			ConversationID: "g_7",
+			Type:           convTypeGroup,
+			LastMessageID:  sql.NullString{String: "m2", Valid: true},
+			MentionCount:   2,
+			UpdatedAt:      base,
+		}
+		p2p := p2pRow(1, 2, base.Add(-time.Second))
+		p2p.LastMessageID = sql.NullString{String: "m1", Valid: true}
+
+		queries.EXPECT().ListPinnedUserConversations(gomock.Any(), gomock.Any()).Return(nil, nil)
+		queries.EXPECT().ListUserConversations(gomock.Any(), gomock.Any()).Return([]dao.ListUserConversationsRow{group, p2p}, nil)
+		queries.EXPECT().ListUsersByIDs(gomock.Any(), []uint64{2}).Return([]dao.ListUsersByIDsRow{{ID: 2, Nickname: "bob"}}, nil)
+		queries.EXPECT().ListGroupsByIDs(gomock.Any(), []uint64{7}).Return([]dao.Group{{ID: 7, Name: "team", UserNum: 3}}, nil)
+
+		resp, err := service.ListConversations(ctx, &messagepb.ListConversationsRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Conversations, 2)
+		assert.Equal(t, recalledPreview, resp.Conversations[0].LastMessagePreview)
+		assert.Equal(t, "team", resp.Conversations[0].GetGroup().GetName())
+		assert.Equal(t, int32(2), resp.Conversations[0].MentionCount)
+		assert.Nil(t, resp.Conversations[0].Peer)
+		assert.Equal(t, "hi there", resp.Conversations[1].LastMessagePreview)
+		assert.Equal(t, "bob", resp.Conversations[1].GetPeer().GetNickname())
+	})
+
+	t.Run("查询失败", func(t *testing.T) {
+		queries.EXPECT().ListPinnedUserConversations(gomock.Any(), gomock.Any()).Return(nil, sql.ErrConnDone)
+
+		_, err := service.ListConversations(ctx, &messagepb.ListConversationsRequest{})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("游标字段不完整", func(t *testing.T) {
+		_, err := service.ListConversations(ctx, &messagepb.ListConversationsRequest{CursorUpdatedAt: base.UnixMilli()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = service.ListConversations(ctx, &messagepb.ListConversationsRequest{CursorConversationId: "p_1_3"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("每页条数超过上限", func(t *testing.T) {
+		_, err := service.ListConversations(ctx, &messagepb.ListConversationsRequest{Limit: 101})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("未登录", func(t *testing.T) {
+		_, err := service.ListConversations(context.Background(), &messagepb.ListConversationsRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestP2PPeer(t *testing.T) {
+	peer, ok := p2pPeer(json.RawMessage(`[1,2]`), 1)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), peer)
+
+	// 与自己的会话返回自己
+	peer, ok = p2pPeer(json.RawMessage(`[1]`), 1)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), peer)
+
+	_, ok = p2pPeer(json.RawMessage(`[]`), 1)
+	assert.False(t, ok)
+	_, ok = p2pPeer(json.RawMessage(`bad`), 1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,181 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math"
+
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultHistoryLimit = 20
+
+// GetConversationMessages 按 seq 游标分页拉取会话历史消息
+func (s *MessageExtService) GetConversationMessages(ctx context.Context, req *messagepb.GetConversationMessagesRequest) (*messagepb.GetConversationMessagesReply, error) {
+	uid, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// 1. 校验调用方是否为会话参与者
+	if _, err := s.checkParticipant(ctx, req.ConversationId, uid); err != nil {
+		return nil, err
+	}
+
+	limit := int32(req.Limit)
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+
+	// 2. 按游标从 message_index 读取 seq 区间（多取一条用于判断 has_more）
+	var (
+		rows []dao.MessageIndex
+		err  error
+	)
+	forward := req.AfterSeq > 0 && req.BeforeSeq == 0
+	if forward {
+		rows, err = s.queries.GetConversationMessagesAfter(ctx, dao.GetConversationMessagesAfterParams{
+			ConversationID: req.ConversationId,
+			Seq:            req.AfterSeq,
+			Limit:          limit + 1,
+		})
+	} else {
+		before := req.BeforeSeq
+		if before == 0 {
+			before = math.MaxInt64
+		}
+		rows, err = s.queries.GetConversationMessages(ctx, dao.GetConversationMessagesParams{
+			ConversationID: req.ConversationId,
+			AfterSeq:       req.AfterSeq,
+			BeforeSeq:      before,
+			Limit:          limit + 1,
+		})
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load message index: %v", err)
+	}
+
+	hasMore := len(rows) > int(limit)
+	if hasMore {
+		rows = rows[:limit]
+	}
+	// 统一按 seq 升序返回
+	if !forward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	// 3. 从 Mongo 补全消息体
+	messages, err := s.fillMessageBodies(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+	return &messagepb.GetConversationMessagesReply{
+		Messages: messages,
+		HasMore:  hasMore,
+	}, nil
+}
+
+// checkParticipant 校验用户是否为会话参与者：单聊以 conversation.participants 为准，群聊以 group_user 为准
+func (s *MessageExtService) checkParticipant(ctx context.Context, convID string, uid uint64) (*dao.Conversation, error) {
+	conv, err := s.queries.GetConversation(ctx, convID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "conversation not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get conversation: %v", err)
+	}
+	if conv.Type == convTypeGroup {
+		groupID, ok := parseGroupConvID(conv.ConversationID)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "invalid group conversation id %q", conv.ConversationID)
+		}
+		if _, err := s.queries.GetGroupUser(ctx, dao.GetGroupUserParams{GroupID: groupID, UserID: uid}); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Error(codes.PermissionDenied, "not a group member")
+			}
+			return nil, status.Errorf(codes.Internal, "get group user: %v", err)
+		}
+		return &conv, nil
+	}
+	var participants []uint64
+	if err := json.Unmarshal(conv.Participants, &participants); err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid participants: %v", err)
+	}
+	for _, p := range participants {
+		if p == uid {
+			return &conv, nil
+		}
+	}
+	return nil, status.Error(codes.PermissionDenied, "not a participant of conversation")
+}
+
+// fillMessageBodies 将索引行与 Mongo 中的消息体组装为 MessageInfo
+func (s *MessageExtService) fillMessageBodies(ctx context.Context, rows []dao.MessageIndex) ([]*messagepb.MessageInfo, error) {
+	ids := make([]string, len(rows))
+	for i, r := range rows {
+		ids[i] = r.MessageID
+	}
+	bodies, err := s.mongo.GetMessageBodies(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load message bodies: %v", err)
+	}
+	quotes, err := s.loadQuotes(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*messagepb.MessageInfo, len(rows))
+	for i, r := range rows {
+		info := &messagepb.MessageInfo{
+			MessageId:      r.MessageID,
+			ConversationId: r.ConversationID,
+			SenderId:       r.SenderID,
+			RecipientId:    r.RecipientID,
+			Seq:            r.Seq,
+			MessageType:    int32(r.MessageType),
+			Status:         int32(r.Status.Int16),
+			CreatedAt:      r.CreatedAt.UnixMilli(),
+			ReplyTo:        quotes[r.ReplyToMsgID.String],
+		}
+		// 已撤回的消息不再下发内容
+		if body, ok := bodies[r.MessageID]; ok && !body.Recalled && r.Status.Int16 != msgStatusRecalled {
+			info.Content = decodeContent(body.Body)
+		}
+		messages[i] = info
+	}
+	return messages, nil
+}
+
+// conversationMembers 返回会话的全部成员：单聊取 participants，群聊取 group_user
+func (s *MessageExtService) conversationMembers(ctx context.Context, conv *dao.Conversation) ([]uint64, error) {
+	if conv.Type == convTypeGroup {
+		groupID, ok := parseGroupConvID(conv.ConversationID)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "invalid group conversation id %q", conv.ConversationID)
+		}
+		users, err := s.queries.GetGroupUsers(ctx, groupID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "get group users: %v", err)
+		}
+		members := make([]uint64, len(users))
+		for i, u := range users {
+			members[i] = u.UserID
+		}
+		return members, nil
+	}
+	var participants []uint64
+	if err := json.Unmarshal(conv.Participants, &participants); err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid participants: %v", err)
+	}
+	return participants, nil
+}
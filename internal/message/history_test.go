@@ -0,0 +1,233 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"testing"
+
+	"im-server/pkg/dao"
+	mock_dao "im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/messagepb"
+	mongostore "im-server/pkg/storage/mongo"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// fakeStore 内存消息体存储
+type fakeStore struct {
+	bodies   map[string]*mongostore.MessageBody
+	saved    []*mongostore.MessageBody
+	recalled []string
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{bodies: make(map[string]*mongostore.MessageBody)}
+}
+
+func (f *fakeStore) SaveMessageBody(ctx context.Context, mb *mongostore.MessageBody) error {
+	f.saved = append(f.saved, mb)
+	f.bodies[mb.MessageID] = mb
+	return nil
+}
+
+func (f *fakeStore) GetMessageBodies(ctx context.Context, messageIDs []string) (map[string]*mongostore.MessageBody, error) {
+	bodies := make(map[string]*mongostore.MessageBody, len(messageIDs))
+	for _, id := range messageIDs {
+		if mb, ok := f.bodies[id]; ok {
+			bodies[id] = mb
+		}
+	}
+	return bodies, nil
+}
+
+func (f *fakeStore) MarkRecalled(ctx context.Context, messageID string) error {
+	f.recalled = append(f.recalled, messageID)
+	if mb, ok := f.bodies[messageID]; ok {
+		mb.Recalled = true
+	}
+	return nil
+}
+
+// putText 存入一条文本消息体
+func (f *fakeStore) putText(messageID, text string) {
+	body, _ := protojson.Marshal(textContent(text))
+	f.bodies[messageID] = &mongostore.MessageBody{MessageID: messageID, Body: body}
+}
+
+func textContent(text string) *messagepb.MessageContent {
+	return &messagepb.MessageContent{Content: &messagepb.MessageContent_Text{Text: &messagepb.TextContent{Text: text}}}
+}
+
+// userCtx 携带登录用户身份的上下文
+func userCtx(uid uint64) context.Context {
+	return context.WithValue(context.Background(), "user_id", uid)
+}
+
+// descRows 生成 seq 从 from 递减到 to 的消息索引，与向前翻页的查询顺序一致
+func descRows(convID string, from, to int64) []dao.MessageIndex {
+	var rows []dao.MessageIndex
+	for seq := from; seq >= to; seq-- {
+		rows = append(rows, dao.MessageIndex{ConversationID: convID, Seq: seq})
+	}
+	return rows
+}
+
+func seqsOf(messages []*messagepb.MessageInfo) []int64 {
+	seqs := make([]int64, len(messages))
+	for i, m := range messages {
+		seqs[i] = m.Seq
+	}
+	return seqs
+}
+
+// 测试GetConversationMessages接口
+func TestGetConversationMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	store := newFakeStore()
+	service := &MessageExtService{queries: queries, mongo: store}
+	ctx := userCtx(1)
+
+	t.Run("首页从最新消息向前翻页", func(t *testing.T) {
+		expectP2PConversation(queries, "p_1_99", 1)
+		queries.EXPECT().
+			GetConversationMessages(gomock.Any(), dao.GetConversationMessagesParams{
+				ConversationID: "p_1_99",
+				BeforeSeq:      math.MaxInt64,
+				Limit:          4,
+			}).
+			Return(descRows("p_1_99", 30, 27), nil)
+
+		resp, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "p_1_99", Limit: 3})
+		require.NoError(t, err)
+		assert.True(t, resp.HasMore)
+		// 多取的一条用于判断 has_more，结果按 seq 升序返回
+		assert.Equal(t, []int64{28, 29, 30}, seqsOf(resp.Messages))
+	})
+
+	t.Run("按before_seq翻到最早一页", func(t *testing.T) {
+		expectP2PConversation(queries, "p_1_99", 1)
+		queries.EXPECT().
+			GetConversationMessages(gomock.Any(), dao.GetConversationMessagesParams{
+				ConversationID: "p_1_99",
+				BeforeSeq:      3,
+				Limit:          defaultHistoryLimit + 1,
+			}).
+			Return(descRows("p_1_99", 2, 1), nil)
+
+		resp, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "p_1_99", BeforeSeq: 3})
+		require.NoError(t, err)
+		assert.False(t, resp.HasMore)
+		assert.Equal(t, []int64{1, 2}, seqsOf(resp.Messages))
+	})
+
+	t.Run("仅指定after_seq时向后拉取", func(t *testing.T) {
+		expectP2PConversation(queries, "p_1_99", 1)
+		expectMessagesAfter(queries, "p_1_99", 10, indexRows("p_1_99", 11, 13))
+
+		resp, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "p_1_99", AfterSeq: 10, Limit: 2})
+		require.NoError(t, err)
+		assert.True(t, resp.HasMore)
+		assert.Equal(t, []int64{11, 12}, seqsOf(resp.Messages))
+	})
+
+	t.Run("同时指定before_seq与after_seq时取区间内最新的消息", func(t *testing.T) {
+		expectP2PConversation(queries, "p_1_99", 1)
+		queries.EXPECT().
+			GetConversationMessages(gomock.Any(), dao.GetConversationMessagesParams{
+				ConversationID: "p_1_99",
+				AfterSeq:       10,
+				BeforeSeq:      14,
+				Limit:          defaultHistoryLimit + 1,
+			}).
+			Return(descRows("p_1_99", 13, 11), nil)
+
+		resp, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "p_1_99", AfterSeq: 10, BeforeSeq: 14})
+		require.NoError(t, err)
+		assert.False(t, resp.HasMore)
+		assert.Equal(t, []int64{11, 12, 13}, seqsOf(resp.Messages))
+	})
+
+	t.Run("空会话返回空页", func(t *testing.T) {
+		expectP2PConversation(queries, "p_1_99", 1)
+		queries.EXPECT().GetConversationMessages(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		resp, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "p_1_99"})
+		require.NoError(t, err)
+		assert.False(t, resp.HasMore)
+		assert.Empty(t, resp.Messages)
+	})
+
+	t.Run("补全消息体且已撤回的消息不下发内容", func(t *testing.T) {
+		store.putText("m1", "hello")
+		store.putText("m2", "secret")
+		store.bodies["m2"].Recalled = true
+		store.putText("m3", "gone")
+
+		expectP2PConversation(queries, "p_1_99", 1)
+		queries.EXPECT().GetConversationMessages(gomock.Any(), gomock.Any()).Return([]dao.MessageIndex{
+			{MessageID: "m3", ConversationID: "p_1_99", Seq: 3, Status: sql.NullInt16{Int16: msgStatusRecalled, Valid: true}},
+			{MessageID: "m2", ConversationID: "p_1_99", Seq: 2, Status: sql.NullInt16{Int16: msgStatusNormal, Valid: true}},
+			{MessageID: "m1", ConversationID: "p_1_99", Seq: 1, SenderID: 99, Status: sql.NullInt16{Int16: msgStatusNormal, Valid: true}},
+		}, nil)
+
+		resp, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "p_1_99"})
+		require.NoError(t, err)
+		require.Len(t, resp.Messages, 3)
+		assert.Equal(t, "hello", resp.Messages[0].GetContent().GetText().GetText())
+		assert.Equal(t, uint64(99), resp.Messages[0].SenderId)
+		assert.Nil(t, resp.Messages[1].Content)
+		assert.Nil(t, resp.Messages[2].Content)
+		assert.Equal(t, int32(msgStatusRecalled), resp.Messages[2].Status)
+	})
+
+	t.Run("群成员可以拉取群聊历史", func(t *testing.T) {
+		queries.EXPECT().GetConversation(gomock.Any(), "g_5").Return(dao.Conversation{ConversationID: "g_5", Type: convTypeGroup}, nil)
+		queries.EXPECT().GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 5, UserID: 1}).Return(dao.GroupUser{GroupID: 5, UserID: 1}, nil)
+		queries.EXPECT().GetConversationMessages(gomock.Any(), gomock.Any()).Return(descRows("g_5", 1, 1), nil)
+
+		resp, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "g_5"})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, seqsOf(resp.Messages))
+	})
+
+	t.Run("非群成员不能拉取群聊历史", func(t *testing.T) {
+		queries.EXPECT().GetConversation(gomock.Any(), "g_5").Return(dao.Conversation{ConversationID: "g_5", Type: convTypeGroup}, nil)
+		queries.EXPECT().GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 5, UserID: 1}).Return(dao.GroupUser{}, sql.ErrNoRows)
+
+		_, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "g_5"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("非参与者不能拉取单聊历史", func(t *testing.T) {
+		expectP2PConversation(queries, "p_2_99", 2)
+
+		_, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "p_2_99"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("会话不存在", func(t *testing.T) {
+		queries.EXPECT().GetConversation(gomock.Any(), "p_1_98").Return(dao.Conversation{}, sql.ErrNoRows)
+
+		_, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "p_1_98"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("每页条数超过上限", func(t *testing.T) {
+		_, err := service.GetConversationMessages(ctx, &messagepb.GetConversationMessagesRequest{ConversationId: "p_1_99", Limit: 101})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("未登录", func(t *testing.T) {
+		_, err := service.GetConversationMessages(context.Background(), &messagepb.GetConversationMessagesRequest{ConversationId: "p_1_99"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -0,0 +1,110 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MarkConversationRead 推进当前用户在会话中的 last_read_seq、清零未读并清除已读位置及之前的 @，随后通过 Outbox 发布 message.read 事件
+func (s *MessageExtService) MarkConversationRead(ctx context.Context, req *messagepb.MarkConversationReadRequest) (*messagepb.MarkConversationReadReply, error) {
+	uid, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	conv, err := s.checkParticipant(ctx, req.ConversationId, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	// 已读位置不能超过会话最新 seq；0 表示读到最新
+	readSeq := req.ReadSeq
+	if readSeq == 0 || readSeq > conv.LastSeq.Int64 {
+		readSeq = conv.LastSeq.Int64
+	}
+	// 群聊已读回执需要本次新读到的 seq 区间，先取出原已读位置
+	var prevReadSeq sql.NullInt64
+	if conv.Type == convTypeGroup {
+		prevReadSeq, err = s.queries.GetLastReadSeq(ctx, dao.GetLastReadSeqParams{UserID: uid, ConversationID: req.ConversationId})
+		if err != nil && err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "get last read seq: %v", err)
+		}
+	}
+	err = s.inTx(ctx, func(q dao.Querier) error {
+		// 先删除已读位置及之前的 @ 明细，MarkRead 再按剩余明细统计未读 @
+		if err := q.DeleteReadMentions(ctx, dao.DeleteReadMentionsParams{
+			UserID:         uid,
+			ConversationID: req.ConversationId,
+			Seq:            readSeq,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "delete read mentions: %v", err)
+		}
+		if err := q.MarkRead(ctx, dao.MarkReadParams{
+			ReadSeq:        readSeq,
+			UserID:         uid,
+			ConversationID: req.ConversationId,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "mark read: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 单聊的已读回执推送给双方（包括自己的其他设备，用于多端未读同步）；
+	// 群聊推送给自己的其他设备和本次新读到的消息的发送者
+	var participants []uint64
+	if conv.Type == convTypeP2P {
+		participants, err = s.conversationMembers(ctx, conv)
+	} else {
+		participants, err = s.readReceiptReceivers(ctx, req.ConversationId, uid, prevReadSeq.Int64, readSeq)
+	}
+	if err != nil {
+		return nil, err
+	}
+	payload, _ := json.Marshal(map[string]any{
+		"conversation_id": req.ConversationId,
+		"reader_id":       uid,
+		"read_seq":        readSeq,
+		"participants":    participants,
+	})
+	s.publishEvent(ctx, "message.read", req.ConversationId, payload)
+
+	return &messagepb.MarkConversationReadReply{
+		ConversationId: req.ConversationId,
+		ReadSeq:        readSeq,
+	}, nil
+}
+
+// readReceiptReceivers 返回群聊已读回执的接收者：读者自己及 (prevReadSeq, readSeq] 内消息的发送者
+func (s *MessageExtService) readReceiptReceivers(ctx context.Context, convID string, uid uint64, prevReadSeq, readSeq int64) ([]uint64, error) {
+	receivers := []uint64{uid}
+	if readSeq <= prevReadSeq {
+		return receivers, nil
+	}
+	senders, err := s.queries.ListMessageSenders(ctx, dao.ListMessageSendersParams{
+		ConversationID: convID,
+		AfterSeq:       prevReadSeq,
+		ToSeq:          readSeq,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list message senders: %v", err)
+	}
+	for _, id := range senders {
+		if id != uid {
+			receivers = append(receivers, id)
+		}
+	}
+	return receivers, nil
+}
@@ -0,0 +1,194 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"im-server/pkg/dao"
+	mock_dao "im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// publishedEvent 一条已发布的事件
+type publishedEvent struct {
+	topic   string
+	key     string
+	payload []byte
+}
+
+// fakePublisher 记录发布的事件
+type fakePublisher struct {
+	events []publishedEvent
+}
+
+func (f *fakePublisher) Topic(name string) string { return name }
+
+func (f *fakePublisher) Publish(ctx context.Context, topic string, key, value []byte) error {
+	f.events = append(f.events, publishedEvent{topic: topic, key: string(key), payload: value})
+	return nil
+}
+
+// last 返回最近一条事件的 payload
+func (f *fakePublisher) last(t *testing.T) map[string]any {
+	require.NotEmpty(t, f.events)
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(f.events[len(f.events)-1].payload, &payload))
+	return payload
+}
+
+// 测试MarkConversationRead接口
+func TestMarkConversationRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	kafka := &fakePublisher{}
+	service := &MessageExtService{queries: queries, kafka: kafka}
+	ctx := userCtx(1)
+
+	p2p := func(lastSeq int64) dao.Conversation {
+		participants, _ := json.Marshal([]uint64{1, 2})
+		return dao.Conversation{
+			ConversationID: "p_1_2",
+			Type:           convTypeP2P,
+			Participants:   participants,
+			LastSeq:        sql.NullInt64{Int64: lastSeq, Valid: true},
+		}
+	}
+
+	t.Run("单聊已读回执推送给双方", func(t *testing.T) {
+		queries.EXPECT().GetConversation(gomock.Any(), "p_1_2").Return(p2p(20), nil)
+		queries.EXPECT().DeleteReadMentions(gomock.Any(), dao.DeleteReadMentionsParams{UserID: 1, ConversationID: "p_1_2", Seq: 15}).Return(nil)
+		queries.EXPECT().MarkRead(gomock.Any(), dao.MarkReadParams{ReadSeq: 15, UserID: 1, ConversationID: "p_1_2"}).Return(nil)
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{ConversationId: "p_1_2", ReadSeq: 15})
+		require.NoError(t, err)
+		assert.Equal(t, int64(15), resp.ReadSeq)
+
+		payload := kafka.last(t)
+		assert.Equal(t, "message.read", kafka.events[len(kafka.events)-1].topic)
+		assert.Equal(t, float64(1), payload["reader_id"])
+		assert.Equal(t, float64(15), payload["read_seq"])
+		assert.Equal(t, []any{float64(1), float64(2)}, payload["participants"])
+	})
+
+	t.Run("未指定已读位置时读到最新", func(t *testing.T) {
+		queries.EXPECT().GetConversation(gomock.Any(), "p_1_2").Return(p2p(20), nil)
+		queries.EXPECT().DeleteReadMentions(gomock.Any(), dao.DeleteReadMentionsParams{UserID: 1, ConversationID: "p_1_2", Seq: 20}).Return(nil)
+		queries.EXPECT().MarkRead(gomock.Any(), dao.MarkReadParams{ReadSeq: 20, UserID: 1, ConversationID: "p_1_2"}).Return(nil)
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{ConversationId: "p_1_2"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(20), resp.ReadSeq)
+	})
+
+	t.Run("已读位置超过最新seq时截断", func(t *testing.T) {
+		queries.EXPECT().GetConversation(gomock.Any(), "p_1_2").Return(p2p(20), nil)
+		queries.EXPECT().DeleteReadMentions(gomock.Any(), dao.DeleteReadMentionsParams{UserID: 1, ConversationID: "p_1_2", Seq: 20}).Return(nil)
+		queries.EXPECT().MarkRead(gomock.Any(), dao.MarkReadParams{ReadSeq: 20, UserID: 1, ConversationID: "p_1_2"}).Return(nil)
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{ConversationId: "p_1_2", ReadSeq: 99})
+		require.NoError(t, err)
+		assert.Equal(t, int64(20), resp.ReadSeq)
+	})
+
+	group := dao.Conversation{
+		ConversationID: "g_5",
+		Type:           convTypeGroup,
+		LastSeq:        sql.NullInt64{Int64: 8, Valid: true},
+	}
+	expectGroupRead := func(prevReadSeq, readSeq int64) {
+		queries.EXPECT().GetConversation(gomock.Any(), "g_5").Return(group, nil)
+		queries.EXPECT().GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 5, UserID: 1}).Return(dao.GroupUser{GroupID: 5, UserID: 1}, nil)
+		queries.EXPECT().GetLastReadSeq(gomock.Any(), dao.GetLastReadSeqParams{UserID: 1, ConversationID: "g_5"}).Return(sql.NullInt64{Int64: prevReadSeq, Valid: true}, nil)
+		queries.EXPECT().DeleteReadMentions(gomock.Any(), dao.DeleteReadMentionsParams{UserID: 1, ConversationID: "g_5", Seq: readSeq}).Return(nil)
+		queries.EXPECT().MarkRead(gomock.Any(), dao.MarkReadParams{ReadSeq: readSeq, UserID: 1, ConversationID: "g_5"}).Return(nil)
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	t.Run("群聊已读回执推送给自己和新读到的消息的发送者", func(t *testing.T) {
+		expectGroupRead(3, 8)
+		queries.EXPECT().ListMessageSenders(gomock.Any(), dao.ListMessageSendersParams{ConversationID: "g_5", AfterSeq: 3, ToSeq: 8}).Return([]uint64{2, 1, 4}, nil)
+
+		_, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{ConversationId: "g_5"})
+		require.NoError(t, err)
+		assert.Equal(t, []any{float64(1), float64(2), float64(4)}, kafka.last(t)["participants"])
+	})
+
+	t.Run("群聊已读位置未推进时只同步给自己的其他设备", func(t *testing.T) {
+		expectGroupRead(8, 5)
+
+		_, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{ConversationId: "g_5", ReadSeq: 5})
+		require.NoError(t, err)
+		assert.Equal(t, []any{float64(1)}, kafka.last(t)["participants"])
+	})
+
+	t.Run("首次读群聊时从头统计发送者", func(t *testing.T) {
+		queries.EXPECT().GetConversation(gomock.Any(), "g_5").Return(group, nil)
+		queries.EXPECT().GetGroupUser(gomock.Any(), gomock.Any()).Return(dao.GroupUser{GroupID: 5, UserID: 1}, nil)
+		queries.EXPECT().GetLastReadSeq(gomock.Any(), gomock.Any()).Return(sql.NullInt64{}, sql.ErrNoRows)
+		queries.EXPECT().DeleteReadMentions(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().MarkRead(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().ListMessageSenders(gomock.Any(), dao.ListMessageSendersParams{ConversationID: "g_5", AfterSeq: 0, ToSeq: 8}).Return([]uint64{3}, nil)
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{ConversationId: "g_5"})
+		require.NoError(t, err)
+		assert.Equal(t, []any{float64(1), float64(3)}, kafka.last(t)["participants"])
+	})
+
+	t.Run("Outbox写入失败不影响已读", func(t *testing.T) {
+		queries.EXPECT().GetConversation(gomock.Any(), "p_1_2").Return(p2p(20), nil)
+		queries.EXPECT().DeleteReadMentions(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().MarkRead(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(sql.ErrConnDone)
+
+		_, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{ConversationId: "p_1_2"})
+		require.NoError(t, err)
+	})
+
+	t.Run("更新已读失败", func(t *testing.T) {
+		queries.EXPECT().GetConversation(gomock.Any(), "p_1_2").Return(p2p(20), nil)
+		queries.EXPECT().DeleteReadMentions(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().MarkRead(gomock.Any(), gomock.Any()).Return(sql.ErrConnDone)
+
+		_, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{ConversationId: "p_1_2"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("清除已读@失败", func(t *testing.T) {
+		queries.EXPECT().GetConversation(gomock.Any(), "p_1_2").Return(p2p(20), nil)
+		queries.EXPECT().DeleteReadMentions(gomock.Any(), gomock.Any()).Return(sql.ErrConnDone)
+
+		_, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{ConversationId: "p_1_2"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("非参与者不能标记已读", func(t *testing.T) {
+		expectP2PConversation(queries, "p_2_99", 2)
+
+		_, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{ConversationId: "p_2_99"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("缺少会话ID", func(t *testing.T) {
+		_, err := service.MarkConversationRead(ctx, &messagepb.MarkConversationReadRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("未登录", func(t *testing.T) {
+		_, err := service.MarkConversationRead(context.Background(), &messagepb.MarkConversationReadRequest{ConversationId: "p_1_2"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
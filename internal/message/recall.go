@@ -0,0 +1,90 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultRecallWindow = 2 * time.Minute
+
+// recallWindow 返回消息可撤回的时间窗口，未配置或格式错误时使用默认值
+func recallWindow() time.Duration {
+	if d, err := time.ParseDuration(config.Config.Services.Message.RecallWindow); err == nil && d > 0 {
+		return d
+	}
+	return defaultRecallWindow
+}
+
+// RecallMessage 撤回消息：仅发送者可在时间窗口内撤回，更新索引状态与 Mongo 消息体，并向所有参与者推送撤回事件
+func (s *MessageExtService) RecallMessage(ctx context.Context, req *messagepb.RecallMessageRequest) (*messagepb.RecallMessageReply, error) {
+	uid, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// 1. 校验消息归属与撤回窗口
+	idx, err := s.queries.GetMessageIndex(ctx, req.MessageId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "message not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get message index: %v", err)
+	}
+	if idx.SenderID != uid {
+		return nil, status.Error(codes.PermissionDenied, "only the sender can recall the message")
+	}
+	if idx.Status.Int16 == msgStatusRecalled {
+		return nil, status.Error(codes.FailedPrecondition, "message already recalled")
+	}
+	if time.Since(idx.CreatedAt) > recallWindow() {
+		return nil, status.Error(codes.FailedPrecondition, "recall window exceeded")
+	}
+
+	conv, err := s.queries.GetConversation(ctx, idx.ConversationID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get conversation: %v", err)
+	}
+	participants, err := s.conversationMembers(ctx, &conv)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 更新索引状态与 Mongo 消息体
+	if err := s.queries.UpdateMessageIndexStatus(ctx, dao.UpdateMessageIndexStatusParams{
+		Status:    sql.NullInt16{Int16: msgStatusRecalled, Valid: true},
+		MessageID: idx.MessageID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "update message status: %v", err)
+	}
+	if err := s.mongo.MarkRecalled(ctx, idx.MessageID); err != nil {
+		return nil, status.Errorf(codes.Internal, "mark body recalled: %v", err)
+	}
+
+	// 3. 通过 Outbox → Kafka → connect 推送给会话内所有参与者
+	payload, _ := json.Marshal(map[string]any{
+		"message_id":      idx.MessageID,
+		"conversation_id": idx.ConversationID,
+		"seq":             idx.Seq,
+		"operator_id":     uid,
+		"participants":    participants,
+	})
+	s.publishEvent(ctx, "message.recall", idx.ConversationID, payload)
+
+	return &messagepb.RecallMessageReply{
+		MessageId:      idx.MessageID,
+		ConversationId: idx.ConversationID,
+		Seq:            idx.Seq,
+	}, nil
+}
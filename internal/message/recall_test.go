@@ -0,0 +1,150 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"im-server/pkg/config"
+	"im-server/pkg/dao"
+	mock_dao "im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecallWindow(t *testing.T) {
+	window := config.Config.Services.Message.RecallWindow
+	defer func() { config.Config.Services.Message.RecallWindow = window }()
+
+	config.Config.Services.Message.RecallWindow = "5m"
+	assert.Equal(t, 5*time.Minute, recallWindow())
+
+	for _, v := range []string{"", "bad", "-1m", "0s"} {
+		config.Config.Services.Message.RecallWindow = v
+		assert.Equal(t, defaultRecallWindow, recallWindow(), v)
+	}
+}
+
+// 测试RecallMessage接口
+func TestRecallMessage(t *testing.T) {
+	window := config.Config.Services.Message.RecallWindow
+	config.Config.Services.Message.RecallWindow = "2m"
+	defer func() { config.Config.Services.Message.RecallWindow = window }()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	store := newFakeStore()
+	kafka := &fakePublisher{}
+	service := &MessageExtService{queries: queries, mongo: store, kafka: kafka}
+	ctx := userCtx(1)
+
+	index := func(sentAgo time.Duration, st int16) dao.MessageIndex {
+		return dao.MessageIndex{
+			MessageID:      "m1",
+			ConversationID: "p_1_2",
+			SenderID:       1,
+			Seq:            7,
+			Status:         sql.NullInt16{Int16: st, Valid: true},
+			CreatedAt:      time.Now().Add(-sentAgo),
+		}
+	}
+	participants, _ := json.Marshal([]uint64{1, 2})
+	p2p := dao.Conversation{ConversationID: "p_1_2", Type: convTypeP2P, Participants: participants}
+
+	t.Run("发送者在窗口内撤回", func(t *testing.T) {
+		store.putText("m1", "hello")
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m1").Return(index(time.Minute, msgStatusNormal), nil)
+		queries.EXPECT().GetConversation(gomock.Any(), "p_1_2").Return(p2p, nil)
+		queries.EXPECT().UpdateMessageIndexStatus(gomock.Any(), dao.UpdateMessageIndexStatusParams{
+			Status:    sql.NullInt16{Int16: msgStatusRecalled, Valid: true},
+			MessageID: "m1",
+		}).Return(nil)
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := service.RecallMessage(ctx, &messagepb.RecallMessageRequest{MessageId: "m1"})
+		require.NoError(t, err)
+		assert.Equal(t, "p_1_2", resp.ConversationId)
+		assert.Equal(t, int64(7), resp.Seq)
+		assert.Equal(t, []string{"m1"}, store.recalled)
+		assert.True(t, store.bodies["m1"].Recalled)
+
+		payload := kafka.last(t)
+		assert.Equal(t, "message.recall", kafka.events[len(kafka.events)-1].topic)
+		assert.Equal(t, float64(1), payload["operator_id"])
+		assert.Equal(t, []any{float64(1), float64(2)}, payload["participants"])
+	})
+
+	t.Run("群聊撤回推送给全体成员", func(t *testing.T) {
+		idx := index(time.Second, msgStatusNormal)
+		idx.ConversationID = "g_5"
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m1").Return(idx, nil)
+		queries.EXPECT().GetConversation(gomock.Any(), "g_5").Return(dao.Conversation{ConversationID: "g_5", Type: convTypeGroup}, nil)
+		queries.EXPECT().GetGroupUsers(gomock.Any(), uint64(5)).Return([]dao.GroupUser{{UserID: 1}, {UserID: 2}, {UserID: 3}}, nil)
+		queries.EXPECT().UpdateMessageIndexStatus(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := service.RecallMessage(ctx, &messagepb.RecallMessageRequest{MessageId: "m1"})
+		require.NoError(t, err)
+		assert.Equal(t, []any{float64(1), float64(2), float64(3)}, kafka.last(t)["participants"])
+	})
+
+	t.Run("超过撤回窗口", func(t *testing.T) {
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m1").Return(index(3*time.Minute, msgStatusNormal), nil)
+
+		_, err := service.RecallMessage(ctx, &messagepb.RecallMessageRequest{MessageId: "m1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "window")
+	})
+
+	t.Run("非发送者不能撤回", func(t *testing.T) {
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m1").Return(index(time.Second, msgStatusNormal), nil)
+
+		_, err := service.RecallMessage(userCtx(2), &messagepb.RecallMessageRequest{MessageId: "m1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("重复撤回", func(t *testing.T) {
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m1").Return(index(time.Second, msgStatusRecalled), nil)
+
+		_, err := service.RecallMessage(ctx, &messagepb.RecallMessageRequest{MessageId: "m1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "already")
+	})
+
+	t.Run("消息不存在", func(t *testing.T) {
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m404").Return(dao.MessageIndex{}, sql.ErrNoRows)
+
+		_, err := service.RecallMessage(ctx, &messagepb.RecallMessageRequest{MessageId: "m404"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("更新索引失败时不修改消息体", func(t *testing.T) {
+		store.recalled = nil
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m1").Return(index(time.Second, msgStatusNormal), nil)
+		queries.EXPECT().GetConversation(gomock.Any(), "p_1_2").Return(p2p, nil)
+		queries.EXPECT().UpdateMessageIndexStatus(gomock.Any(), gomock.Any()).Return(sql.ErrConnDone)
+
+		_, err := service.RecallMessage(ctx, &messagepb.RecallMessageRequest{MessageId: "m1"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Empty(t, store.recalled)
+	})
+
+	t.Run("缺少消息ID", func(t *testing.T) {
+		_, err := service.RecallMessage(ctx, &messagepb.RecallMessageRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("未登录", func(t *testing.T) {
+		_, err := service.RecallMessage(context.Background(), &messagepb.RecallMessageRequest{MessageId: "m1"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
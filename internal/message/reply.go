@@ -0,0 +1,82 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resolveQuote 校验被引用消息属于同一会话，并生成引用摘要；未引用时返回 nil
+func (s *MessageExtService) resolveQuote(ctx context.Context, convID, replyToMsgID string) (*messagepb.QuotedMessage, error) {
+	if replyToMsgID == "" {
+		return nil, nil
+	}
+	idx, err := s.queries.GetMessageIndex(ctx, replyToMsgID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.InvalidArgument, "reply_to message not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get reply_to message: %v", err)
+	}
+	if idx.ConversationID != convID {
+		return nil, status.Error(codes.InvalidArgument, "reply_to message belongs to another conversation")
+	}
+	quotes, err := s.buildQuotes(ctx, []dao.MessageIndex{idx})
+	if err != nil {
+		return nil, err
+	}
+	return quotes[replyToMsgID], nil
+}
+
+// loadQuotes 批量加载索引行所引用的消息摘要，返回 reply_to_msg_id -> 摘要
+func (s *MessageExtService) loadQuotes(ctx context.Context, rows []dao.MessageIndex) (map[string]*messagepb.QuotedMessage, error) {
+	ids := make([]string, 0, len(rows))
+	for _, r := range rows {
+		if r.ReplyToMsgID.Valid && r.ReplyToMsgID.String != "" {
+			ids = append(ids, r.ReplyToMsgID.String)
+		}
+	}
+	if len(ids) == 0 {
+		return map[string]*messagepb.QuotedMessage{}, nil
+	}
+	refs, err := s.queries.ListMessageIndexByIDs(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load reply_to index: %v", err)
+	}
+	return s.buildQuotes(ctx, refs)
+}
+
+// buildQuotes 根据被引用消息的索引与 Mongo 消息体生成摘要
+func (s *MessageExtService) buildQuotes(ctx context.Context, refs []dao.MessageIndex) (map[string]*messagepb.QuotedMessage, error) {
+	ids := make([]string, len(refs))
+	for i, r := range refs {
+		ids[i] = r.MessageID
+	}
+	bodies, err := s.mongo.GetMessageBodies(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load reply_to bodies: %v", err)
+	}
+
+	quotes := make(map[string]*messagepb.QuotedMessage, len(refs))
+	for _, r := range refs {
+		q := &messagepb.QuotedMessage{
+			MessageId:   r.MessageID,
+			SenderId:    r.SenderID,
+			MessageType: int32(r.MessageType),
+		}
+		body, ok := bodies[r.MessageID]
+		switch {
+		case r.Status.Int16 == msgStatusRecalled || (ok && body.Recalled):
+			q.Preview = recalledPreview
+		case ok:
+			q.Preview = previewOf(decodeContent(body.Body))
+		}
+		quotes[r.MessageID] = q
+	}
+	return quotes, nil
+}
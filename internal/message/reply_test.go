@@ -0,0 +1,164 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"im-server/pkg/dao"
+	mock_dao "im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestResolveQuote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	store := newFakeStore()
+	service := &MessageExtService{queries: queries, mongo: store}
+	ctx := context.Background()
+	normal := sql.NullInt16{Int16: msgStatusNormal, Valid: true}
+
+	t.Run("未引用消息", func(t *testing.T) {
+		quote, err := service.resolveQuote(ctx, "p_1_2", "")
+		require.NoError(t, err)
+		assert.Nil(t, quote)
+	})
+
+	t.Run("引用同一会话的消息生成摘要", func(t *testing.T) {
+		store.putText("m1", "原始消息")
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m1").Return(dao.MessageIndex{
+			MessageID: "m1", ConversationID: "p_1_2", SenderID: 2, MessageType: 1, Status: normal,
+		}, nil)
+
+		quote, err := service.resolveQuote(ctx, "p_1_2", "m1")
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(&messagepb.QuotedMessage{MessageId: "m1", SenderId: 2, MessageType: 1, Preview: "原始消息"}, quote))
+	})
+
+	t.Run("引用已撤回的消息", func(t *testing.T) {
+		store.putText("m2", "撤回前")
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m2").Return(dao.MessageIndex{
+			MessageID: "m2", ConversationID: "p_1_2", Status: sql.NullInt16{Int16: msgStatusRecalled, Valid: true},
+		}, nil)
+
+		quote, err := service.resolveQuote(ctx, "p_1_2", "m2")
+		require.NoError(t, err)
+		assert.Equal(t, recalledPreview, quote.Preview)
+	})
+
+	t.Run("消息体缺失时摘要为空", func(t *testing.T) {
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m3").Return(dao.MessageIndex{MessageID: "m3", ConversationID: "p_1_2", Status: normal}, nil)
+
+		quote, err := service.resolveQuote(ctx, "p_1_2", "m3")
+		require.NoError(t, err)
+		assert.Equal(t, "m3", quote.MessageId)
+		assert.Empty(t, quote.Preview)
+	})
+
+	t.Run("引用其他会话的消息", func(t *testing.T) {
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m1").Return(dao.MessageIndex{MessageID: "m1", ConversationID: "g_5"}, nil)
+
+		_, err := service.resolveQuote(ctx, "p_1_2", "m1")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("引用的消息不存在", func(t *testing.T) {
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m404").Return(dao.MessageIndex{}, sql.ErrNoRows)
+
+		_, err := service.resolveQuote(ctx, "p_1_2", "m404")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("查询引用消息失败", func(t *testing.T) {
+		queries.EXPECT().GetMessageIndex(gomock.Any(), "m1").Return(dao.MessageIndex{}, sql.ErrConnDone)
+
+		_, err := service.resolveQuote(ctx, "p_1_2", "m1")
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestHistoryQuotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	store := newFakeStore()
+	service := &MessageExtService{queries: queries, mongo: store}
+
+	store.putText("m1", "被引用")
+	store.putText("m2", "回复")
+	store.putText("m3", "再回复")
+	reply := func(id string, seq int64) dao.MessageIndex {
+		return dao.MessageIndex{
+			MessageID:      id,
+			ConversationID: "p_1_99",
+			Seq:            seq,
+			ReplyToMsgID:   sql.NullString{String: "m1", Valid: true},
+			Status:         sql.NullInt16{Int16: msgStatusNormal, Valid: true},
+		}
+	}
+
+	expectP2PConversation(queries, "p_1_99", 1)
+	queries.EXPECT().GetConversationMessages(gomock.Any(), gomock.Any()).Return([]dao.MessageIndex{
+		reply("m3", 3),
+		reply("m2", 2),
+		{MessageID: "m1", ConversationID: "p_1_99", Seq: 1, SenderID: 99},
+	}, nil)
+	// 本页全部引用批量查询一次
+	queries.EXPECT().ListMessageIndexByIDs(gomock.Any(), []string{"m1", "m1"}).Return([]dao.MessageIndex{
+		{MessageID: "m1", ConversationID: "p_1_99", SenderID: 99, MessageType: 1},
+	}, nil)
+
+	resp, err := service.GetConversationMessages(userCtx(1), &messagepb.GetConversationMessagesRequest{ConversationId: "p_1_99"})
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 3)
+	assert.Nil(t, resp.Messages[0].ReplyTo)
+	for _, m := range resp.Messages[1:] {
+		require.NotNil(t, m.ReplyTo)
+		assert.Equal(t, "m1", m.ReplyTo.MessageId)
+		assert.Equal(t, uint64(99), m.ReplyTo.SenderId)
+		assert.Equal(t, "被引用", m.ReplyTo.Preview)
+	}
+}
+
+func TestStoreMessageReplyTo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	kafka := &fakePublisher{}
+	service := &MessageExtService{queries: queries, mongo: newFakeStore(), kafka: kafka}
+	target := &sendTarget{convID: "p_1_2", convType: convTypeP2P, recipientID: 2, recipients: []uint64{2}}
+	quote := &messagepb.QuotedMessage{MessageId: "m1", SenderId: 2, MessageType: 1, Preview: "原始消息"}
+
+	queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+	queries.EXPECT().ListUsersByIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+	queries.EXPECT().UpsertConversationOnSend(gomock.Any(), gomock.Any()).Return(nil)
+	queries.EXPECT().UpsertUserConversationOnSend(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	queries.EXPECT().InsertMessageIndex(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, arg dao.InsertMessageIndexParams) error {
+			assert.Equal(t, sql.NullString{String: "m1", Valid: true}, arg.ReplyToMsgID)
+			return nil
+		})
+	queries.EXPECT().IncrUnreadOnRecipient(gomock.Any(), dao.IncrUnreadOnRecipientParams{UserID: 2, ConversationID: "p_1_2"}).Return(nil)
+
+	_, err := service.storeMessageWithOutbox(context.Background(), 1,
+		&messagepb.SendMessageRequest{RecipientId: 2, ReplyToMsgId: "m1", Content: textContent("回复")}, target, quote, 4)
+	require.NoError(t, err)
+
+	// 投递事件携带引用摘要
+	var event connectpb.MessageEvent
+	require.NoError(t, protojson.Unmarshal(lastDeliver(t, kafka).Event, &event))
+	assert.True(t, proto.Equal(quote, event.ReplyTo))
+}
@@ -6,31 +6,50 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"im-server/pkg/broker"
 	"im-server/pkg/config"
 	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/connectpb"
 	"im-server/pkg/protocol/pb/messagepb"
 	mongostore "im-server/pkg/storage/mongo"
 
 	"github.com/go-redis/redis/v8"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // MessageExtService 消息服务
 type MessageExtService struct {
 	messagepb.UnimplementedMessageExtServiceServer
+	db      *sql.DB
 	queries dao.Querier
 	rdb     redis.Cmdable
-	mongo   *mongostore.Client
-	kafka   *broker.KafkaProducer
+	mongo   messageStore
+	kafka   eventPublisher
+}
+
+// messageStore 消息体存储，由 Mongo 客户端实现
+type messageStore interface {
+	SaveMessageBody(ctx context.Context, mb *mongostore.MessageBody) error
+	GetMessageBodies(ctx context.Context, messageIDs []string) (map[string]*mongostore.MessageBody, error)
+	MarkRecalled(ctx context.Context, messageID string) error
+}
+
+// eventPublisher 事件发布，由 Kafka 生产者实现
+type eventPublisher interface {
+	Topic(name string) string
+	Publish(ctx context.Context, topic string, key, value []byte) error
 }
 
 // NewMessageExtService 创建一个新的 MessageExtService 实例
-func NewMessageExtService(queries dao.Querier, rdb redis.Cmdable, mongo *mongostore.Client) *MessageExtService {
+func NewMessageExtService(db *sql.DB, queries dao.Querier, rdb redis.Cmdable, mongo *mongostore.Client) *MessageExtService {
 	return &MessageExtService{
+		db:      db,
 		queries: queries,
 		rdb:     rdb,
 		mongo:   mongo,
@@ -38,7 +57,7 @@ func NewMessageExtService(queries dao.Querier, rdb redis.Cmdable, mongo *mongost
 	}
 }
 
-// SendMessage 发送单聊消息
+// SendMessage 发送单聊/群聊消息
 func (s *MessageExtService) SendMessage(ctx context.Context, req *messagepb.SendMessageRequest) (*messagepb.SendMessageReply, error) {
 	// 1. 获取用户身份
 	uid, ok1 := ctx.Value("user_id").(uint64)
@@ -51,6 +70,9 @@ func (s *MessageExtService) SendMessage(ctx context.Context, req *messagepb.Send
 	if err := req.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if req.GetContent().GetSystem() != nil {
+		return nil, status.Error(codes.InvalidArgument, "system content is reserved for server")
+	}
 
 	// 3. 幂等
 	idKey := fmt.Sprintf("msg:%d:%d:%s", uid, did, req.ClientMsgId)
@@ -60,20 +82,23 @@ func (s *MessageExtService) SendMessage(ctx context.Context, req *messagepb.Send
 		return &resp, nil
 	}
 
-	// 4. 校验好友关系
-	cnt, err := s.queries.CheckFriendship(ctx, dao.CheckFriendshipParams{UserID: uid, FriendID: req.RecipientId})
-	if err != nil || cnt == 0 {
-		return nil, status.Error(codes.PermissionDenied, "not friends")
+	// 4. 解析发送目标：单聊校验好友关系，群聊校验群成员身份
+	target, err := s.resolveTarget(ctx, uid, req)
+	if err != nil {
+		return nil, err
 	}
 
-	// 5. 会话ID（单聊：min_uid_max_uid）
-	convID := buildP2PConvID(uid, req.RecipientId)
+	// 5. 校验引用消息（须属于同一会话）
+	quote, err := s.resolveQuote(ctx, target.convID, req.ReplyToMsgId)
+	if err != nil {
+		return nil, err
+	}
 
-	// 6. 序列号（Redis 递增）
-	seq := s.rdb.Incr(ctx, fmt.Sprintf("conv_seq:%s", convID)).Val()
+	// 6. 序列号（Redis 按会话递增，群聊即为群内 seq）
+	seq := s.rdb.Incr(ctx, fmt.Sprintf("conv_seq:%s", target.convID)).Val()
 
 	// 7. 组装并持久化（Mongo 消息体 + MySQL 索引 + Outbox）
-	resp, err := s.storeMessageWithOutbox(ctx, uid, req, convID, seq)
+	resp, err := s.storeMessageWithOutbox(ctx, uid, req, target, quote, seq)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +109,115 @@ func (s *MessageExtService) SendMessage(ctx context.Context, req *messagepb.Send
 	return resp, nil
 }
 
+// 会话类型，与 conversation.type 一致
+const (
+	convTypeP2P   = 1 // 单聊
+	convTypeGroup = 2 // 群聊
+)
+
+// 消息状态，与 message_index.status 一致
+const (
+	msgStatusRecalled = 1 // 已撤回
+	msgStatusNormal   = 2 // 正常
+)
+
+// sendTarget 描述一次发送的目标会话
+type sendTarget struct {
+	convID      string
+	convType    int8
+	recipientID uint64   // 单聊为对端用户ID，群聊为群组ID
+	groupID     uint64   // 群聊群组ID
+	recipients  []uint64 // 需要投递的用户（不含发送者）
+	mentions    []uint64 // 被 @ 的用户（仅群聊，已过滤非成员与发送者）
+}
+
+// resolveTarget 根据请求确定目标会话并做权限校验
+func (s *MessageExtService) resolveTarget(ctx context.Context, uid uint64, req *messagepb.SendMessageRequest) (*sendTarget, error) {
+	switch {
+	case req.GroupId > 0 && req.RecipientId > 0:
+		return nil, status.Error(codes.InvalidArgument, "recipient_id and group_id are mutually exclusive")
+	case req.GroupId > 0:
+		target, sender, err := s.groupTarget(ctx, uid, req.GroupId)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkMuted(ctx, sender); err != nil {
+			return nil, err
+		}
+		if target.mentions, err = resolveMentions(sender, target, req.GetContent()); err != nil {
+			return nil, err
+		}
+		return target, nil
+	case req.RecipientId > 0:
+		if len(req.GetContent().GetMentionIds()) > 0 || req.GetContent().GetMentionAll() {
+			return nil, status.Error(codes.InvalidArgument, "mentions are only allowed in group messages")
+		}
+		cnt, err := s.queries.CheckFriendship(ctx, dao.CheckFriendshipParams{UserID: uid, FriendID: req.RecipientId})
+		if err != nil || cnt == 0 {
+			return nil, status.Error(codes.PermissionDenied, "not friends")
+		}
+		// 会话ID（单聊：min_uid_max_uid）
+		return &sendTarget{
+			convID:      buildP2PConvID(uid, req.RecipientId),
+			convType:    convTypeP2P,
+			recipientID: req.RecipientId,
+			recipients:  []uint64{req.RecipientId},
+		}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "recipient_id or group_id is required")
+	}
+}
+
+// groupTarget 构造群聊发送目标，同时返回发送者的群成员记录；非群成员返回 PermissionDenied
+func (s *MessageExtService) groupTarget(ctx context.Context, uid, groupID uint64) (*sendTarget, *dao.GroupUser, error) {
+	members, err := s.queries.GetGroupUsers(ctx, groupID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "get group users: %v", err)
+	}
+	target := &sendTarget{
+		convID:      buildGroupConvID(groupID),
+		convType:    convTypeGroup,
+		recipientID: groupID,
+		groupID:     groupID,
+	}
+	var sender *dao.GroupUser
+	for i, m := range members {
+		if m.UserID == uid {
+			sender = &members[i]
+			continue
+		}
+		target.recipients = append(target.recipients, m.UserID)
+	}
+	if sender == nil {
+		return nil, nil, status.Error(codes.PermissionDenied, "not a group member")
+	}
+	return target, sender, nil
+}
+
+// resolveMentions 解析群消息中的 @：@全体仅群主/管理员可用，指定成员时忽略非成员与发送者自身
+func resolveMentions(sender *dao.GroupUser, target *sendTarget, content *messagepb.MessageContent) ([]uint64, error) {
+	if content.GetMentionAll() {
+		if !isGroupManager(sender.MemberType) {
+			return nil, status.Error(codes.PermissionDenied, "only owner or admin can mention all")
+		}
+		return target.recipients, nil
+	}
+	if len(content.GetMentionIds()) == 0 {
+		return nil, nil
+	}
+	members := make(map[uint64]struct{}, len(target.recipients))
+	for _, id := range target.recipients {
+		members[id] = struct{}{}
+	}
+	var mentions []uint64
+	for _, id := range content.GetMentionIds() {
+		if _, ok := members[id]; ok {
+			mentions = append(mentions, id)
+		}
+	}
+	return mentions, nil
+}
+
 func buildP2PConvID(a, b uint64) string {
 	if a < b {
 		return fmt.Sprintf("p_%d_%d", a, b)
@@ -91,19 +225,37 @@ func buildP2PConvID(a, b uint64) string {
 	return fmt.Sprintf("p_%d_%d", b, a)
 }
 
+func buildGroupConvID(groupID uint64) string {
+	return fmt.Sprintf("g_%d", groupID)
+}
+
+// parseGroupConvID 从群聊会话ID（g_<groupId>）中解析群组ID
+func parseGroupConvID(convID string) (uint64, bool) {
+	if !strings.HasPrefix(convID, "g_") {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(convID[2:], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
 // storeMessageWithOutbox 将消息体写入 Mongo，并在一个 DB 事务内写入索引/会话/未读；Outbox 在 Mongo 成功后立即写入
-func (s *MessageExtService) storeMessageWithOutbox(ctx context.Context, senderID uint64, req *messagepb.SendMessageRequest, convID string, seq int64) (*messagepb.SendMessageReply, error) {
+func (s *MessageExtService) storeMessageWithOutbox(ctx context.Context, senderID uint64, req *messagepb.SendMessageRequest, target *sendTarget, quote *messagepb.QuotedMessage, seq int64) (*messagepb.SendMessageReply, error) {
+	convID := target.convID
 	// 生成 message_id（简单用时间+seq，可换为雪花）
-	msgID := fmt.Sprintf("%d-%d", time.Now().UnixNano(), seq)
+	now := time.Now()
+	msgID := fmt.Sprintf("%d-%d", now.UnixNano(), seq)
 
 	// 1) 保存 Mongo 消息体
 	contentType := inferContentType(req.GetContent())
-	bodyRaw, _ := json.Marshal(req.GetContent())
+	bodyRaw, _ := protojson.Marshal(req.GetContent())
 	if err := s.mongo.SaveMessageBody(ctx, &mongostore.MessageBody{
 		MessageID:      msgID,
 		ConversationID: convID,
 		SenderID:       senderID,
-		RecipientID:    req.RecipientId,
+		RecipientID:    target.recipientID,
 		Type:           contentType,
 		Body:           bodyRaw,
 	}); err != nil {
@@ -111,13 +263,34 @@ func (s *MessageExtService) storeMessageWithOutbox(ctx context.Context, senderID
 	}
 
 	// 1.5) 立即写入 Outbox（与后续 MySQL 事务解耦，用于失败补偿与异步投递）
+	// event 为完整的投递消息（protojson），connect 层直接转为 MessageEvent 推送给客户端
+	event, _ := protojson.Marshal(&connectpb.MessageEvent{
+		MessageId:        msgID,
+		ConversationId:   convID,
+		ConversationType: int32(target.convType),
+		GroupId:          target.groupID,
+		Seq:              seq,
+		Sender:           s.senderProfile(ctx, senderID),
+		RecipientId:      target.recipientID,
+		MessageType:      contentType,
+		Content:          req.GetContent(),
+		ReplyTo:          quote,
+		SendTime:         now.UnixMilli(),
+		ClientMsgId:      req.ClientMsgId,
+	})
 	payload, _ := json.Marshal(map[string]any{
-		"message_id":      msgID,
-		"conversation_id": convID,
-		"seq":             seq,
-		"sender_id":       senderID,
-		"recipient_id":    req.RecipientId,
-		"type":            contentType,
+		"message_id":        msgID,
+		"conversation_id":   convID,
+		"conversation_type": target.convType,
+		"seq":               seq,
+		"sender_id":         senderID,
+		"recipient_id":      target.recipientID,
+		"group_id":          target.groupID,
+		"recipients":        target.recipients,
+		"mentions":          target.mentions,
+		"reply_to":          quote,
+		"type":              contentType,
+		"event":             json.RawMessage(event),
 	})
 	if err := s.queries.InsertOutboxEvent(ctx, dao.InsertOutboxEventParams{Topic: "message.deliver", Payload: payload}); err != nil {
 		// 不阻断主流程：记录日志，后续仍尝试 MySQL 事务与即时发布
@@ -125,64 +298,76 @@ func (s *MessageExtService) storeMessageWithOutbox(ctx context.Context, senderID
 	}
 
 	// 2) MySQL 事务：索引/会话/未读
-	tx, ok := s.queries.(*dao.Queries) // 需要底层 *Queries 才能开启事务
-	if !ok {
-		return nil, status.Error(codes.Internal, "queries type not *dao.Queries")
-	}
-	// 从 *Queries 提取底层 DBTX 为 *sql.DB
-	dbtx, ok := any(tx).(interface {
-		BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
-	})
-	if !ok {
-		return nil, status.Error(codes.Internal, "db does not support transactions")
-	}
-	sqlTx, err := dbtx.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
-	}
-	q := tx.WithTx(sqlTx)
-	defer func() {
+	err := s.inTx(ctx, func(q dao.Querier) error {
+		if target.convType == convTypeGroup {
+			// Upsert 群聊会话（type=2）
+			if err := q.UpsertGroupConversationOnSend(ctx, dao.UpsertGroupConversationOnSendParams{
+				ConversationID: convID,
+				LastMessageID:  sql.NullString{String: msgID, Valid: true},
+				LastSeq:        sql.NullInt64{Int64: seq, Valid: true},
+			}); err != nil {
+				return status.Errorf(codes.Internal, "upsert group conversation: %v", err)
+			}
+			// Upsert 全体成员 user_conversation
+			if err := q.UpsertGroupUserConversationsOnSend(ctx, dao.UpsertGroupUserConversationsOnSendParams{ConversationID: convID, GroupID: target.groupID}); err != nil {
+				return status.Errorf(codes.Internal, "upsert member convs: %v", err)
+			}
+		} else {
+			// Upsert 会话
+			if err := q.UpsertConversationOnSend(ctx, dao.UpsertConversationOnSendParams{
+				ConversationID: convID,
+				JSONARRAY:      senderID,
+				JSONARRAY_2:    target.recipientID,
+				LastMessageID:  sql.NullString{String: msgID, Valid: true},
+				LastSeq:        sql.NullInt64{Int64: seq, Valid: true},
+			}); err != nil {
+				return status.Errorf(codes.Internal, "upsert conversation: %v", err)
+			}
+			// Upsert 双方 user_conversation
+			if err := q.UpsertUserConversationOnSend(ctx, dao.UpsertUserConversationOnSendParams{UserID: senderID, ConversationID: convID}); err != nil {
+				return status.Errorf(codes.Internal, "upsert sender conv: %v", err)
+			}
+			if err := q.UpsertUserConversationOnSend(ctx, dao.UpsertUserConversationOnSendParams{UserID: target.recipientID, ConversationID: convID}); err != nil {
+				return status.Errorf(codes.Internal, "upsert recipient conv: %v", err)
+			}
+		}
+		// 插入消息索引
+		if err := q.InsertMessageIndex(ctx, dao.InsertMessageIndexParams{
+			MessageID:      msgID,
+			ConversationID: convID,
+			SenderID:       senderID,
+			RecipientID:    target.recipientID,
+			MessageType:    int8(contentType),
+			Seq:            seq,
+			ReplyToMsgID:   sql.NullString{String: req.ReplyToMsgId, Valid: req.ReplyToMsgId != ""},
+			Status:         sql.NullInt16{Int16: msgStatusNormal, Valid: true},
+		}); err != nil {
+			return status.Errorf(codes.Internal, "insert index: %v", err)
+		}
+		// 未读 +1（单聊为接收方，群聊为除发送者外的全体成员）
+		var err error
+		if target.convType == convTypeGroup {
+			err = q.IncrGroupUnreadExceptSender(ctx, dao.IncrGroupUnreadExceptSenderParams{ConversationID: convID, SenderID: senderID, GroupID: target.groupID})
+		} else {
+			err = q.IncrUnreadOnRecipient(ctx, dao.IncrUnreadOnRecipientParams{UserID: target.recipientID, ConversationID: convID})
+		}
 		if err != nil {
-			_ = sqlTx.Rollback()
+			return status.Errorf(codes.Internal, "incr unread: %v", err)
 		}
-	}()
-
-	// Upsert 会话
-	if err = q.UpsertConversationOnSend(ctx, dao.UpsertConversationOnSendParams{
-		ConversationID: convID,
-		JSONARRAY:      senderID,
-		JSONARRAY_2:    req.RecipientId,
-		LastMessageID:  sql.NullString{String: msgID, Valid: true},
-		LastSeq:        sql.NullInt64{Int64: seq, Valid: true},
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "upsert conversation: %v", err)
-	}
-	// Upsert 双方 user_conversation
-	if err = q.UpsertUserConversationOnSend(ctx, dao.UpsertUserConversationOnSendParams{UserID: senderID, ConversationID: convID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "upsert sender conv: %v", err)
-	}
-	if err = q.UpsertUserConversationOnSend(ctx, dao.UpsertUserConversationOnSendParams{UserID: req.RecipientId, ConversationID: convID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "upsert recipient conv: %v", err)
-	}
-	// 插入消息索引
-	if err = q.InsertMessageIndex(ctx, dao.InsertMessageIndexParams{
-		MessageID:      msgID,
-		ConversationID: convID,
-		SenderID:       senderID,
-		RecipientID:    req.RecipientId,
-		MessageType:    int8(contentType),
-		Seq:            seq,
-		Status:         sql.NullInt16{Int16: 2, Valid: true},
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "insert index: %v", err)
-	}
-	// 未读 +1（接收方）
-	if err = q.IncrUnreadOnRecipient(ctx, dao.IncrUnreadOnRecipientParams{UserID: req.RecipientId, ConversationID: convID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "incr unread: %v", err)
-	}
-
-	if err = sqlTx.Commit(); err != nil {
-		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+		// 被 @ 的成员单独计数（不受免打扰影响）
+		if len(target.mentions) > 0 {
+			if err := q.IncrMentionOnUsers(ctx, dao.IncrMentionOnUsersParams{Seq: seq, ConversationID: convID, UserIds: target.mentions}); err != nil {
+				return status.Errorf(codes.Internal, "incr mention: %v", err)
+			}
+			// 记录 @ 明细，标记已读时据此只清除已读位置及之前的 @
+			if err := q.InsertUserMentions(ctx, dao.InsertUserMentionsParams{Seq: seq, ConversationID: convID, UserIds: target.mentions}); err != nil {
+				return status.Errorf(codes.Internal, "insert mentions: %v", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// 3) 尝试立即异步投递到 Kafka（即使失败也有 Outbox 兜底/补偿）
@@ -194,11 +379,49 @@ func (s *MessageExtService) storeMessageWithOutbox(ctx context.Context, senderID
 		MessageId:      msgID,
 		ConversationId: convID,
 		Seq:            seq,
-		ServerTime:     time.Now().UnixMilli(),
+		ServerTime:     now.UnixMilli(),
 		ClientMsgId:    req.ClientMsgId,
 	}, nil
 }
 
+// inTx 在一个数据库事务内执行 fn；queries 不是 *dao.Queries（如单元测试中的 mock）或未提供 db 时直接执行
+func (s *MessageExtService) inTx(ctx context.Context, fn func(q dao.Querier) error) error {
+	queries, ok := s.queries.(*dao.Queries)
+	if !ok || s.db == nil {
+		return fn(s.queries)
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	if err := fn(queries.WithTx(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return nil
+}
+
+// senderProfile 读取发送者资料用于投递；失败时仅返回用户ID，不阻断发送
+func (s *MessageExtService) senderProfile(ctx context.Context, uid uint64) *messagepb.PeerInfo {
+	users, err := s.queries.ListUsersByIDs(ctx, []uint64{uid})
+	if err != nil || len(users) == 0 {
+		if err != nil {
+			log.Printf("warn: load sender %d profile failed: %v", uid, err)
+		}
+		return &messagepb.PeerInfo{UserId: uid}
+	}
+	u := users[0]
+	return &messagepb.PeerInfo{
+		UserId:    u.ID,
+		Username:  u.Username,
+		Nickname:  u.Nickname,
+		AvatarUrl: u.AvatarUrl,
+	}
+}
+
 func inferContentType(c *messagepb.MessageContent) int32 {
 	switch c.GetContent().(type) {
 	case *messagepb.MessageContent_Text:
@@ -209,7 +432,58 @@ func inferContentType(c *messagepb.MessageContent) int32 {
 		return 3
 	case *messagepb.MessageContent_File:
 		return 5
+	case *messagepb.MessageContent_System:
+		return msgTypeSystem
 	default:
 		return 0
 	}
 }
+
+// decodeContent 将 Mongo 中以 protojson 存储的消息体还原为 MessageContent，无法解析时返回 nil
+func decodeContent(raw []byte) *messagepb.MessageContent {
+	if len(raw) == 0 {
+		return nil
+	}
+	var c messagepb.MessageContent
+	if err := protojson.Unmarshal(raw, &c); err != nil {
+		return nil
+	}
+	return &c
+}
+
+const (
+	previewMaxRunes = 50
+	recalledPreview = "[消息已撤回]"
+)
+
+// previewOf 生成消息内容的摘要，用于会话列表等展示场景
+func previewOf(c *messagepb.MessageContent) string {
+	switch v := c.GetContent().(type) {
+	case *messagepb.MessageContent_Text:
+		r := []rune(v.Text.GetText())
+		if len(r) > previewMaxRunes {
+			return string(r[:previewMaxRunes]) + "..."
+		}
+		return string(r)
+	case *messagepb.MessageContent_Image:
+		return "[图片]"
+	case *messagepb.MessageContent_Audio:
+		return "[语音]"
+	case *messagepb.MessageContent_File:
+		return "[文件] " + v.File.GetFilename()
+	case *messagepb.MessageContent_System:
+		return v.System.GetText()
+	default:
+		return ""
+	}
+}
+
+// publishEvent 写入 Outbox 并尝试立即发布到 Kafka；任一步失败只记录日志，由 Outbox 兜底补偿
+func (s *MessageExtService) publishEvent(ctx context.Context, topic, key string, payload []byte) {
+	if err := s.queries.InsertOutboxEvent(ctx, dao.InsertOutboxEventParams{Topic: topic, Payload: payload}); err != nil {
+		log.Printf("warn: insert outbox %s failed: %v", topic, err)
+	}
+	if err := s.kafka.Publish(ctx, s.kafka.Topic(topic), []byte(key), payload); err != nil {
+		log.Printf("kafka publish %s failed: %v, will rely on outbox", topic, err)
+	}
+}
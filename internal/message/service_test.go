@@ -0,0 +1,444 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"im-server/pkg/dao"
+	mock_dao "im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/connectpb"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// groupMembers 生成群 5 的成员列表：1 为群主，2 为管理员，其余为普通成员
+func groupMembers(ids ...uint64) []dao.GroupUser {
+	members := make([]dao.GroupUser, len(ids))
+	for i, id := range ids {
+		memberType := int8(2)
+		switch id {
+		case 1:
+			memberType = groupMemberTypeOwner
+		case 2:
+			memberType = groupMemberTypeAdmin
+		}
+		members[i] = dao.GroupUser{GroupID: 5, UserID: id, MemberType: memberType}
+	}
+	return members
+}
+
+// deliverPayload 解析 message.deliver 事件
+type deliverPayload struct {
+	MessageID  string          `json:"message_id"`
+	Seq        int64           `json:"seq"`
+	SenderID   uint64          `json:"sender_id"`
+	GroupID    uint64          `json:"group_id"`
+	Recipients []uint64        `json:"recipients"`
+	Mentions   []uint64        `json:"mentions"`
+	Event      json.RawMessage `json:"event"`
+}
+
+func lastDeliver(t *testing.T, kafka *fakePublisher) deliverPayload {
+	require.NotEmpty(t, kafka.events)
+	e := kafka.events[len(kafka.events)-1]
+	require.Equal(t, "message.deliver", e.topic)
+	var p deliverPayload
+	require.NoError(t, json.Unmarshal(e.payload, &p))
+	return p
+}
+
+func TestResolveTargetGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	service := &MessageExtService{queries: queries}
+	text := textContent("hi")
+
+	t.Run("群成员发送，投递给除自己外的成员", func(t *testing.T) {
+		queries.EXPECT().GetGroupUsers(gomock.Any(), uint64(5)).Return(groupMembers(1, 2, 3, 4), nil)
+		queries.EXPECT().GetGroup(gomock.Any(), uint64(5)).Return(dao.Group{ID: 5}, nil)
+
+		target, err := service.resolveTarget(context.Background(), 3, &messagepb.SendMessageRequest{GroupId: 5, Content: text})
+		require.NoError(t, err)
+		assert.Equal(t, "g_5", target.convID)
+		assert.Equal(t, int8(convTypeGroup), target.convType)
+		assert.Equal(t, uint64(5), target.groupID)
+		assert.Equal(t, uint64(5), target.recipientID)
+		assert.Equal(t, []uint64{1, 2, 4}, target.recipients)
+	})
+
+	t.Run("只有自己的群没有投递对象", func(t *testing.T) {
+		queries.EXPECT().GetGroupUsers(gomock.Any(), uint64(5)).Return(groupMembers(1), nil)
+
+		target, err := service.resolveTarget(context.Background(), 1, &messagepb.SendMessageRequest{GroupId: 5, Content: text})
+		require.NoError(t, err)
+		assert.Empty(t, target.recipients)
+	})
+
+	t.Run("非群成员不能发送", func(t *testing.T) {
+		queries.EXPECT().GetGroupUsers(gomock.Any(), uint64(5)).Return(groupMembers(1, 2), nil)
+
+		_, err := service.resolveTarget(context.Background(), 3, &messagepb.SendMessageRequest{GroupId: 5, Content: text})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("被禁言的成员不能发送", func(t *testing.T) {
+		members := groupMembers(1, 3)
+		members[1].MutedUntil = sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}
+		queries.EXPECT().GetGroupUsers(gomock.Any(), uint64(5)).Return(members, nil)
+
+		_, err := service.resolveTarget(context.Background(), 3, &messagepb.SendMessageRequest{GroupId: 5, Content: text})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "you are muted")
+	})
+
+	t.Run("禁言已到期的成员可以发送", func(t *testing.T) {
+		members := groupMembers(1, 3)
+		members[1].MutedUntil = sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+		queries.EXPECT().GetGroupUsers(gomock.Any(), uint64(5)).Return(members, nil)
+		queries.EXPECT().GetGroup(gomock.Any(), uint64(5)).Return(dao.Group{ID: 5}, nil)
+
+		_, err := service.resolveTarget(context.Background(), 3, &messagepb.SendMessageRequest{GroupId: 5, Content: text})
+		require.NoError(t, err)
+	})
+
+	t.Run("全员禁言时普通成员不能发送", func(t *testing.T) {
+		queries.EXPECT().GetGroupUsers(gomock.Any(), uint64(5)).Return(groupMembers(1, 3), nil)
+		queries.EXPECT().GetGroup(gomock.Any(), uint64(5)).Return(dao.Group{
+			ID:           5,
+			MuteAllUntil: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+		}, nil)
+
+		_, err := service.resolveTarget(context.Background(), 3, &messagepb.SendMessageRequest{GroupId: 5, Content: text})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "group is muted")
+	})
+
+	t.Run("全员禁言不限制群主和管理员", func(t *testing.T) {
+		for _, uid := range []uint64{1, 2} {
+			queries.EXPECT().GetGroupUsers(gomock.Any(), uint64(5)).Return(groupMembers(1, 2, 3), nil)
+
+			_, err := service.resolveTarget(context.Background(), uid, &messagepb.SendMessageRequest{GroupId: 5, Content: text})
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("同时指定接收者和群组", func(t *testing.T) {
+		_, err := service.resolveTarget(context.Background(), 1, &messagepb.SendMessageRequest{RecipientId: 2, GroupId: 5, Content: text})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("未指定接收者和群组", func(t *testing.T) {
+		_, err := service.resolveTarget(context.Background(), 1, &messagepb.SendMessageRequest{Content: text})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("查询群成员失败", func(t *testing.T) {
+		queries.EXPECT().GetGroupUsers(gomock.Any(), uint64(5)).Return(nil, sql.ErrConnDone)
+
+		_, err := service.resolveTarget(context.Background(), 1, &messagepb.SendMessageRequest{GroupId: 5, Content: text})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestStoreGroupMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	store := newFakeStore()
+	kafka := &fakePublisher{}
+	service := &MessageExtService{queries: queries, mongo: store, kafka: kafka}
+	target := &sendTarget{
+		convID:      "g_5",
+		convType:    convTypeGroup,
+		recipientID: 5,
+		groupID:     5,
+		recipients:  []uint64{1, 2},
+	}
+	req := &messagepb.SendMessageRequest{GroupId: 5, ClientMsgId: "c1", Content: textContent("hi all")}
+
+	t.Run("写入群会话、全体成员会话与未读", func(t *testing.T) {
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().ListUsersByIDs(gomock.Any(), []uint64{3}).Return(nil, nil)
+		gomock.InOrder(
+			queries.EXPECT().UpsertGroupConversationOnSend(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, arg dao.UpsertGroupConversationOnSendParams) error {
+					assert.Equal(t, "g_5", arg.ConversationID)
+					assert.Equal(t, int64(9), arg.LastSeq.Int64)
+					return nil
+				}),
+			queries.EXPECT().UpsertGroupUserConversationsOnSend(gomock.Any(), dao.UpsertGroupUserConversationsOnSendParams{ConversationID: "g_5", GroupID: 5}).Return(nil),
+			queries.EXPECT().InsertMessageIndex(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, arg dao.InsertMessageIndexParams) error {
+					assert.Equal(t, uint64(3), arg.SenderID)
+					assert.Equal(t, uint64(5), arg.RecipientID)
+					assert.Equal(t, int64(9), arg.Seq)
+					assert.False(t, arg.ReplyToMsgID.Valid)
+					return nil
+				}),
+			queries.EXPECT().IncrGroupUnreadExceptSender(gomock.Any(), dao.IncrGroupUnreadExceptSenderParams{ConversationID: "g_5", SenderID: 3, GroupID: 5}).Return(nil),
+		)
+
+		resp, err := service.storeMessageWithOutbox(context.Background(), 3, req, target, nil, 9)
+		require.NoError(t, err)
+		assert.Equal(t, "g_5", resp.ConversationId)
+		assert.Equal(t, int64(9), resp.Seq)
+		assert.Equal(t, "c1", resp.ClientMsgId)
+
+		require.Len(t, store.saved, 1)
+		assert.Equal(t, resp.MessageId, store.saved[0].MessageID)
+		assert.Equal(t, uint64(5), store.saved[0].RecipientID)
+
+		payload := lastDeliver(t, kafka)
+		assert.Equal(t, resp.MessageId, payload.MessageID)
+		assert.Equal(t, uint64(5), payload.GroupID)
+		assert.Equal(t, []uint64{1, 2}, payload.Recipients)
+		assert.Empty(t, payload.Mentions)
+	})
+
+	t.Run("事务内写入失败时不投递", func(t *testing.T) {
+		published := len(kafka.events)
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().ListUsersByIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+		queries.EXPECT().UpsertGroupConversationOnSend(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().UpsertGroupUserConversationsOnSend(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().InsertMessageIndex(gomock.Any(), gomock.Any()).Return(sql.ErrConnDone)
+
+		_, err := service.storeMessageWithOutbox(context.Background(), 3, req, target, nil, 10)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Len(t, kafka.events, published)
+	})
+}
+
+func TestResolveMentions(t *testing.T) {
+	target := &sendTarget{convType: convTypeGroup, recipients: []uint64{1, 2, 4}}
+	member := &dao.GroupUser{UserID: 3, MemberType: 2}
+	admin := &dao.GroupUser{UserID: 2, MemberType: groupMemberTypeAdmin}
+	owner := &dao.GroupUser{UserID: 1, MemberType: groupMemberTypeOwner}
+	mentionIDs := func(ids ...uint64) *messagepb.MessageContent {
+		c := textContent("hi")
+		c.MentionIds = ids
+		return c
+	}
+	mentionAll := textContent("hi")
+	mentionAll.MentionAll = true
+
+	tests := []struct {
+		name    string
+		sender  *dao.GroupUser
+		content *messagepb.MessageContent
+		want    []uint64
+		code    codes.Code
+	}{
+		{name: "未@任何人", sender: member, content: textContent("hi")},
+		{name: "@指定成员", sender: member, content: mentionIDs(4, 1), want: []uint64{4, 1}},
+		{name: "忽略非成员与发送者自身", sender: member, content: mentionIDs(3, 9, 2), want: []uint64{2}},
+		{name: "只@了非成员", sender: member, content: mentionIDs(9)},
+		{name: "群主@全体", sender: owner, content: mentionAll, want: []uint64{1, 2, 4}},
+		{name: "管理员@全体", sender: admin, content: mentionAll, want: []uint64{1, 2, 4}},
+		{name: "普通成员不能@全体", sender: member, content: mentionAll, code: codes.PermissionDenied},
+		{name: "未指定成员类型不能@全体", sender: &dao.GroupUser{UserID: 3}, content: mentionAll, code: codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveMentions(tt.sender, target, tt.content)
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolveTargetMentions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	service := &MessageExtService{queries: queries}
+
+	t.Run("群消息解析@成员", func(t *testing.T) {
+		content := textContent("hi")
+		content.MentionIds = []uint64{2, 9}
+		queries.EXPECT().GetGroupUsers(gomock.Any(), uint64(5)).Return(groupMembers(1, 2, 3), nil)
+		queries.EXPECT().GetGroup(gomock.Any(), uint64(5)).Return(dao.Group{ID: 5}, nil)
+
+		target, err := service.resolveTarget(context.Background(), 3, &messagepb.SendMessageRequest{GroupId: 5, Content: content})
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{2}, target.mentions)
+	})
+
+	t.Run("单聊不能@", func(t *testing.T) {
+		content := textContent("hi")
+		content.MentionAll = true
+
+		_, err := service.resolveTarget(context.Background(), 1, &messagepb.SendMessageRequest{RecipientId: 2, Content: content})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestStoreMessageMentionCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	kafka := &fakePublisher{}
+	service := &MessageExtService{queries: queries, mongo: newFakeStore(), kafka: kafka}
+	req := &messagepb.SendMessageRequest{GroupId: 5, Content: textContent("hi")}
+	expectGroupWrites := func() {
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().ListUsersByIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+		queries.EXPECT().UpsertGroupConversationOnSend(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().UpsertGroupUserConversationsOnSend(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().InsertMessageIndex(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().IncrGroupUnreadExceptSender(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	t.Run("被@的成员计数并记录@明细", func(t *testing.T) {
+		expectGroupWrites()
+		queries.EXPECT().IncrMentionOnUsers(gomock.Any(), dao.IncrMentionOnUsersParams{Seq: 12, ConversationID: "g_5", UserIds: []uint64{2, 4}}).Return(nil)
+		queries.EXPECT().InsertUserMentions(gomock.Any(), dao.InsertUserMentionsParams{Seq: 12, ConversationID: "g_5", UserIds: []uint64{2, 4}}).Return(nil)
+
+		target := &sendTarget{convID: "g_5", convType: convTypeGroup, recipientID: 5, groupID: 5, recipients: []uint64{1, 2, 4}, mentions: []uint64{2, 4}}
+		_, err := service.storeMessageWithOutbox(context.Background(), 3, req, target, nil, 12)
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{2, 4}, lastDeliver(t, kafka).Mentions)
+	})
+
+	t.Run("没有@时不更新计数", func(t *testing.T) {
+		expectGroupWrites()
+
+		target := &sendTarget{convID: "g_5", convType: convTypeGroup, recipientID: 5, groupID: 5, recipients: []uint64{1, 2, 4}}
+		_, err := service.storeMessageWithOutbox(context.Background(), 3, req, target, nil, 13)
+		require.NoError(t, err)
+	})
+
+	t.Run("更新计数失败", func(t *testing.T) {
+		expectGroupWrites()
+		queries.EXPECT().IncrMentionOnUsers(gomock.Any(), gomock.Any()).Return(sql.ErrConnDone)
+
+		target := &sendTarget{convID: "g_5", convType: convTypeGroup, recipientID: 5, groupID: 5, recipients: []uint64{2}, mentions: []uint64{2}}
+		_, err := service.storeMessageWithOutbox(context.Background(), 3, req, target, nil, 14)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestStoreMessageEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queries := mock_dao.NewMockQuerier(ctrl)
+	kafka := &fakePublisher{}
+	service := &MessageExtService{queries: queries, mongo: newFakeStore(), kafka: kafka}
+	expectP2PWrites := func() {
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().UpsertConversationOnSend(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().UpsertUserConversationOnSend(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		queries.EXPECT().InsertMessageIndex(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().IncrUnreadOnRecipient(gomock.Any(), gomock.Any()).Return(nil)
+	}
+	lastEvent := func(t *testing.T) *connectpb.MessageEvent {
+		var event connectpb.MessageEvent
+		require.NoError(t, protojson.Unmarshal(lastDeliver(t, kafka).Event, &event))
+		return &event
+	}
+	target := &sendTarget{convID: "p_1_2", convType: convTypeP2P, recipientID: 2, recipients: []uint64{2}}
+
+	t.Run("事件携带完整内容与发送者资料", func(t *testing.T) {
+		expectP2PWrites()
+		queries.EXPECT().ListUsersByIDs(gomock.Any(), []uint64{1}).Return([]dao.ListUsersByIDsRow{
+			{ID: 1, Username: "alice", Nickname: "Alice", AvatarUrl: "a.png"},
+		}, nil)
+
+		content := &messagepb.MessageContent{Content: &messagepb.MessageContent_Image{Image: &messagepb.ImageContent{Url: "x.png", Width: 10}}}
+		resp, err := service.storeMessageWithOutbox(context.Background(), 1,
+			&messagepb.SendMessageRequest{RecipientId: 2, ClientMsgId: "c9", Content: content}, target, nil, 3)
+		require.NoError(t, err)
+
+		event := lastEvent(t)
+		assert.Equal(t, resp.MessageId, event.MessageId)
+		assert.Equal(t, "p_1_2", event.ConversationId)
+		assert.Equal(t, int32(convTypeP2P), event.ConversationType)
+		assert.Zero(t, event.GroupId)
+		assert.Equal(t, int64(3), event.Seq)
+		assert.Equal(t, uint64(2), event.RecipientId)
+		assert.Equal(t, int32(2), event.MessageType)
+		assert.Equal(t, resp.ServerTime, event.SendTime)
+		assert.Equal(t, "c9", event.ClientMsgId)
+		assert.Nil(t, event.ReplyTo)
+		assert.True(t, proto.Equal(content, event.Content))
+		assert.True(t, proto.Equal(&messagepb.PeerInfo{UserId: 1, Username: "alice", Nickname: "Alice", AvatarUrl: "a.png"}, event.Sender))
+	})
+
+	t.Run("读取发送者资料失败时仅携带用户ID", func(t *testing.T) {
+		expectP2PWrites()
+		queries.EXPECT().ListUsersByIDs(gomock.Any(), []uint64{1}).Return(nil, sql.ErrConnDone)
+
+		_, err := service.storeMessageWithOutbox(context.Background(), 1,
+			&messagepb.SendMessageRequest{RecipientId: 2, Content: textContent("hi")}, target, nil, 4)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(&messagepb.PeerInfo{UserId: 1}, lastEvent(t).Sender))
+	})
+
+	t.Run("群聊事件携带群组ID", func(t *testing.T) {
+		queries.EXPECT().InsertOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().ListUsersByIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+		queries.EXPECT().UpsertGroupConversationOnSend(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().UpsertGroupUserConversationsOnSend(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().InsertMessageIndex(gomock.Any(), gomock.Any()).Return(nil)
+		queries.EXPECT().IncrGroupUnreadExceptSender(gomock.Any(), gomock.Any()).Return(nil)
+
+		group := &sendTarget{convID: "g_5", convType: convTypeGroup, recipientID: 5, groupID: 5, recipients: []uint64{2}}
+		_, err := service.storeMessageWithOutbox(context.Background(), 1,
+			&messagepb.SendMessageRequest{GroupId: 5, Content: textContent("hi")}, group, nil, 5)
+		require.NoError(t, err)
+
+		event := lastEvent(t)
+		assert.Equal(t, int32(convTypeGroup), event.ConversationType)
+		assert.Equal(t, uint64(5), event.GroupId)
+		assert.Equal(t, "hi", event.GetContent().GetText().GetText())
+	})
+}
+
+func TestPreviewOf(t *testing.T) {
+	long := strings.Repeat("长", previewMaxRunes+1)
+	tests := []struct {
+		name     string
+		content  *messagepb.MessageContent
+		wantType int32
+		want     string
+	}{
+		{name: "文本", content: textContent("hello"), wantType: 1, want: "hello"},
+		{name: "超长文本截断", content: textContent(long), wantType: 1, want: strings.Repeat("长", previewMaxRunes) + "..."},
+		{name: "图片", content: &messagepb.MessageContent{Content: &messagepb.MessageContent_Image{Image: &messagepb.ImageContent{}}}, wantType: 2, want: "[图片]"},
+		{name: "语音", content: &messagepb.MessageContent{Content: &messagepb.MessageContent_Audio{Audio: &messagepb.AudioContent{}}}, wantType: 3, want: "[语音]"},
+		{name: "文件", content: &messagepb.MessageContent{Content: &messagepb.MessageContent_File{File: &messagepb.FileContent{Filename: "a.pdf"}}}, wantType: 5, want: "[文件] a.pdf"},
+		{name: "系统消息", content: &messagepb.MessageContent{Content: &messagepb.MessageContent_System{System: &messagepb.SystemContent{Text: "x 加入了群聊"}}}, wantType: msgTypeSystem, want: "x 加入了群聊"},
+		{name: "空内容", content: nil, wantType: 0, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantType, inferContentType(tt.content))
+			assert.Equal(t, tt.want, previewOf(tt.content))
+		})
+	}
+}
+
+func TestDecodeContent(t *testing.T) {
+	raw, err := protojson.Marshal(textContent("hi"))
+	require.NoError(t, err)
+	assert.Equal(t, "hi", decodeContent(raw).GetText().GetText())
+	assert.Nil(t, decodeContent(nil))
+	assert.Nil(t, decodeContent([]byte("not json")))
+}
@@ -0,0 +1,37 @@
+package message
+
+import (
+	"context"
+
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResolveSignalTargets 校验瞬时信号（如正在输入）的发送权限并返回接收方。
+// 信号由 connect 层直接转发，不写入 Mongo、message_index 和 outbox
+func (s *MessageIntService) ResolveSignalTargets(ctx context.Context, req *messagepb.ResolveSignalTargetsRequest) (*messagepb.ResolveSignalTargetsReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	switch {
+	case req.GroupId > 0 && req.RecipientId > 0:
+		return nil, status.Error(codes.InvalidArgument, "recipient_id and group_id are mutually exclusive")
+	case req.GroupId > 0:
+		target, _, err := s.ext.groupTarget(ctx, req.UserId, req.GroupId)
+		if err != nil {
+			return nil, err
+		}
+		return &messagepb.ResolveSignalTargetsReply{Recipients: target.recipients}, nil
+	case req.RecipientId > 0:
+		cnt, err := s.ext.queries.CheckFriendship(ctx, dao.CheckFriendshipParams{UserID: req.UserId, FriendID: req.RecipientId})
+		if err != nil || cnt == 0 {
+			return nil, status.Error(codes.PermissionDenied, "not friends")
+		}
+		return &messagepb.ResolveSignalTargetsReply{Recipients: []uint64{req.RecipientId}}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "recipient_id or group_id is required")
+	}
+}
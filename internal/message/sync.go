@@ -0,0 +1,126 @@
+package message
+
+import (
+	"context"
+	"time"
+
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSyncLimit     = 50
+	maxSyncConversations = 500 // 全局模式单次最多发现的会话数，与 cursors 的数量上限一致
+)
+
+// SyncMessages 按会话 seq 游标批量拉取客户端缺失的消息，用于离线同步。
+// 未指定会话游标时以全局收件箱游标展开：自游标时刻起有更新的会话，从该时刻之前的最大 seq 开始同步，
+// 不以已读位置推断同步起点，避免重复下发已送达但未读的消息
+func (s *MessageExtService) SyncMessages(ctx context.Context, req *messagepb.SyncMessagesRequest) (*messagepb.SyncMessagesReply, error) {
+	uid, ok := ctx.Value("user_id").(uint64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.Cursors) > 0 && req.InboxCursor > 0 {
+		return nil, status.Error(codes.InvalidArgument, "cursors and inbox_cursor are mutually exclusive")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultSyncLimit
+	}
+
+	// 1. 全局模式：将收件箱游标展开为各会话游标
+	cursors := req.Cursors
+	var inboxCursor int64
+	if len(cursors) == 0 {
+		now := time.Now()
+		var err error
+		if cursors, err = s.inboxCursors(ctx, uid, req.InboxCursor); err != nil {
+			return nil, err
+		}
+		inboxCursor = now.UnixMilli()
+	}
+
+	// 2. 依次拉取各会话游标之后的消息，直至填满本批
+	rows, next, err := s.syncPage(ctx, uid, cursors, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 从 Mongo 补全消息体
+	messages, err := s.fillMessageBodies(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+	return &messagepb.SyncMessagesReply{
+		Messages:    messages,
+		HasMore:     len(next) > 0,
+		Cursors:     next,
+		InboxCursor: inboxCursor,
+	}, nil
+}
+
+// inboxCursors 将全局收件箱游标展开为各会话游标：自游标时刻起有更新的会话（含离线期间新建的会话），
+// 从该时刻之前的最大 seq 开始同步。created_at 仅精确到秒，游标按秒向下取整，
+// 同一秒内的消息可能重复下发但不会遗漏，客户端按 seq 去重
+func (s *MessageExtService) inboxCursors(ctx context.Context, uid uint64, inboxCursor int64) ([]*messagepb.SyncCursor, error) {
+	since := time.UnixMilli(inboxCursor).Truncate(time.Second)
+	convs, err := s.queries.ListUserConversationsSince(ctx, dao.ListUserConversationsSinceParams{
+		Since:  since,
+		UserID: uid,
+		Limit:  maxSyncConversations,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list updated conversations: %v", err)
+	}
+	cursors := make([]*messagepb.SyncCursor, 0, len(convs))
+	for _, c := range convs {
+		cursors = append(cursors, &messagepb.SyncCursor{ConversationId: c.ConversationID, Seq: c.StartSeq})
+	}
+	return cursors, nil
+}
+
+// syncPage 按游标顺序拉取至多 limit 条消息，返回本批消息索引和下一批的游标：
+// 本批未拉完的会话以最后一条已拉取的 seq 作为新游标，尚未轮到的会话原样保留
+func (s *MessageExtService) syncPage(ctx context.Context, uid uint64, cursors []*messagepb.SyncCursor, limit int) ([]dao.MessageIndex, []*messagepb.SyncCursor, error) {
+	var (
+		rows []dao.MessageIndex
+		next []*messagepb.SyncCursor
+	)
+	for _, cur := range cursors {
+		remaining := limit - len(rows)
+		if remaining <= 0 {
+			next = append(next, cur)
+			continue
+		}
+		if _, err := s.checkParticipant(ctx, cur.ConversationId, uid); err != nil {
+			// 已退出或不存在的会话不再同步
+			if c := status.Code(err); c == codes.NotFound || c == codes.PermissionDenied {
+				continue
+			}
+			return nil, nil, err
+		}
+		page, err := s.queries.GetConversationMessagesAfter(ctx, dao.GetConversationMessagesAfterParams{
+			ConversationID: cur.ConversationId,
+			Seq:            cur.Seq,
+			Limit:          int32(remaining + 1),
+		})
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "load message index: %v", err)
+		}
+		if len(page) > remaining {
+			page = page[:remaining]
+			next = append(next, &messagepb.SyncCursor{ConversationId: cur.ConversationId, Seq: page[len(page)-1].Seq})
+		}
+		rows = append(rows, page...)
+	}
+	return rows, next, nil
+}
@@ -0,0 +1,166 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"im-server/pkg/dao"
+	mock_dao "im-server/pkg/mocks"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// indexRows 生成会话 convID 中 seq 为 from..to 的消息索引
+func indexRows(convID string, from, to int64) []dao.MessageIndex {
+	var rows []dao.MessageIndex
+	for seq := from; seq <= to; seq++ {
+		rows = append(rows, dao.MessageIndex{ConversationID: convID, Seq: seq})
+	}
+	return rows
+}
+
+// expectP2PConversation 模拟 uid 参与的单聊会话
+func expectP2PConversation(q *mock_dao.MockQuerier, convID string, uid uint64) {
+	participants, _ := json.Marshal([]uint64{uid, 99})
+	q.EXPECT().GetConversation(gomock.Any(), convID).Return(dao.Conversation{
+		ConversationID: convID,
+		Type:           convTypeP2P,
+		Participants:   participants,
+	}, nil)
+}
+
+// expectMessagesAfter 模拟 GetConversationMessagesAfter，按 limit 截取 rows
+func expectMessagesAfter(q *mock_dao.MockQuerier, convID string, seq int64, rows []dao.MessageIndex) {
+	q.EXPECT().GetConversationMessagesAfter(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, arg dao.GetConversationMessagesAfterParams) ([]dao.MessageIndex, error) {
+			if arg.ConversationID != convID || arg.Seq != seq {
+				return nil, sql.ErrConnDone
+			}
+			if int(arg.Limit) < len(rows) {
+				return rows[:arg.Limit], nil
+			}
+			return rows, nil
+		})
+}
+
+func TestSyncPageSingleConversation(t *testing.T) {
+	tests := []struct {
+		name     string
+		pending  int64
+		limit    int
+		wantRows int
+		wantNext []*messagepb.SyncCursor
+	}{
+		{name: "fits in one page", pending: 3, limit: 5, wantRows: 3},
+		{name: "exactly one page", pending: 5, limit: 5, wantRows: 5},
+		{name: "partial page", pending: 8, limit: 5, wantRows: 5, wantNext: []*messagepb.SyncCursor{{ConversationId: "p2p_1_99", Seq: 15}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			q := mock_dao.NewMockQuerier(ctrl)
+			s := &MessageExtService{queries: q}
+
+			expectP2PConversation(q, "p2p_1_99", 1)
+			expectMessagesAfter(q, "p2p_1_99", 10, indexRows("p2p_1_99", 11, 10+tt.pending))
+
+			rows, next, err := s.syncPage(context.Background(), 1, []*messagepb.SyncCursor{{ConversationId: "p2p_1_99", Seq: 10}}, tt.limit)
+			require.NoError(t, err)
+			assert.Len(t, rows, tt.wantRows)
+			assert.Equal(t, int64(11), rows[0].Seq)
+			assert.Equal(t, tt.wantNext, next)
+		})
+	}
+}
+
+func TestSyncPageCarryOver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	q := mock_dao.NewMockQuerier(ctrl)
+	s := &MessageExtService{queries: q}
+
+	// a 有 2 条、b 有 4 条、c 尚未轮到，limit 为 4
+	expectP2PConversation(q, "a", 1)
+	expectMessagesAfter(q, "a", 0, indexRows("a", 1, 2))
+	expectP2PConversation(q, "b", 1)
+	expectMessagesAfter(q, "b", 5, indexRows("b", 6, 9))
+
+	cursors := []*messagepb.SyncCursor{
+		{ConversationId: "a", Seq: 0},
+		{ConversationId: "b", Seq: 5},
+		{ConversationId: "c", Seq: 7},
+	}
+	rows, next, err := s.syncPage(context.Background(), 1, cursors, 4)
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, []int64{1, 2, 6, 7}, []int64{rows[0].Seq, rows[1].Seq, rows[2].Seq, rows[3].Seq})
+	// b 从已拉取的最后一条继续，c 的游标原样带到下一批
+	assert.Equal(t, []*messagepb.SyncCursor{
+		{ConversationId: "b", Seq: 7},
+		{ConversationId: "c", Seq: 7},
+	}, next)
+}
+
+func TestSyncPageSkipsLeftConversation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	q := mock_dao.NewMockQuerier(ctrl)
+	s := &MessageExtService{queries: q}
+
+	q.EXPECT().GetConversation(gomock.Any(), "gone").Return(dao.Conversation{}, sql.ErrNoRows)
+	q.EXPECT().GetConversation(gomock.Any(), "g_5").Return(dao.Conversation{ConversationID: "g_5", Type: convTypeGroup}, nil)
+	q.EXPECT().GetGroupUser(gomock.Any(), dao.GetGroupUserParams{GroupID: 5, UserID: 1}).Return(dao.GroupUser{}, sql.ErrNoRows)
+	expectP2PConversation(q, "a", 1)
+	expectMessagesAfter(q, "a", 0, indexRows("a", 1, 1))
+
+	rows, next, err := s.syncPage(context.Background(), 1, []*messagepb.SyncCursor{
+		{ConversationId: "gone"},
+		{ConversationId: "g_5"},
+		{ConversationId: "a"},
+	}, 10)
+	require.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.Empty(t, next)
+}
+
+func TestInboxCursors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	q := mock_dao.NewMockQuerier(ctrl)
+	s := &MessageExtService{queries: q}
+
+	// 游标按秒向下取整；离线期间新建的会话从 seq 0 开始
+	q.EXPECT().ListUserConversationsSince(gomock.Any(), dao.ListUserConversationsSinceParams{
+		Since:  time.UnixMilli(1700000000000),
+		UserID: 1,
+		Limit:  maxSyncConversations,
+	}).Return([]dao.ListUserConversationsSinceRow{
+		{ConversationID: "g_5", StartSeq: 0},
+		{ConversationID: "a", StartSeq: 12},
+	}, nil)
+
+	cursors, err := s.inboxCursors(context.Background(), 1, 1700000000999)
+	require.NoError(t, err)
+	assert.Equal(t, []*messagepb.SyncCursor{
+		{ConversationId: "g_5", Seq: 0},
+		{ConversationId: "a", Seq: 12},
+	}, cursors)
+}
+
+func TestSyncMessagesCursorModes(t *testing.T) {
+	s := &MessageExtService{}
+	ctx := context.WithValue(context.Background(), "user_id", uint64(1))
+	_, err := s.SyncMessages(ctx, &messagepb.SyncMessagesRequest{
+		Cursors:     []*messagepb.SyncCursor{{ConversationId: "a"}},
+		InboxCursor: 1700000000000,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.SyncMessages(context.Background(), &messagepb.SyncMessagesRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,75 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"im-server/pkg/dao"
+	"im-server/pkg/protocol/pb/messagepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// msgTypeSystem 系统消息类型，与 message_index.message_type 一致
+const msgTypeSystem = 7
+
+// 群成员类型，与 group_user.member_type 一致
+const (
+	groupMemberTypeAdmin = 1 // 管理员
+	groupMemberTypeOwner = 3 // 群主
+)
+
+// isGroupManager 是否为群主或管理员；未知类型按普通成员处理
+func isGroupManager(memberType int8) bool {
+	return memberType == groupMemberTypeOwner || memberType == groupMemberTypeAdmin
+}
+
+// checkMuted 校验群成员当前是否被禁言（成员禁言对所有角色生效，全员禁言仅对普通成员生效）
+func (s *MessageExtService) checkMuted(ctx context.Context, sender *dao.GroupUser) error {
+	now := time.Now()
+	if sender.MutedUntil.Valid && sender.MutedUntil.Time.After(now) {
+		return status.Errorf(codes.PermissionDenied, "you are muted in this group until %s", sender.MutedUntil.Time.Format(time.RFC3339))
+	}
+	if isGroupManager(sender.MemberType) {
+		return nil
+	}
+	group, err := s.queries.GetGroup(ctx, sender.GroupID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "get group: %v", err)
+	}
+	if group.MuteAllUntil.Valid && group.MuteAllUntil.Time.After(now) {
+		return status.Errorf(codes.PermissionDenied, "group is muted until %s", group.MuteAllUntil.Time.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// MessageIntService 消息内部服务，复用 MessageExtService 的存储与投递链路
+type MessageIntService struct {
+	messagepb.UnimplementedMessageIntServiceServer
+	ext *MessageExtService
+}
+
+// NewMessageIntService 创建一个新的 MessageIntService 实例
+func NewMessageIntService(ext *MessageExtService) *MessageIntService {
+	return &MessageIntService{ext: ext}
+}
+
+// SendGroupSystemMessage 以操作者身份向群聊会话写入一条系统消息，并投递给其余成员
+func (s *MessageIntService) SendGroupSystemMessage(ctx context.Context, req *messagepb.SendGroupSystemMessageRequest) (*messagepb.SendMessageReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	operatorID := req.Content.OperatorId
+
+	target, _, err := s.ext.groupTarget(ctx, operatorID, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	seq := s.ext.rdb.Incr(ctx, fmt.Sprintf("conv_seq:%s", target.convID)).Val()
+	return s.ext.storeMessageWithOutbox(ctx, operatorID, &messagepb.SendMessageRequest{
+		GroupId: req.GroupId,
+		Content: &messagepb.MessageContent{Content: &messagepb.MessageContent_System{System: req.Content}},
+	}, target, nil, seq)
+}
@@ -58,6 +58,8 @@ func generateGRPCClientConfig(services ServiceConfig) GRPCClientConfig {
 		DeviceTargetAddr:  fmt.Sprintf("addrs:///%s", services.Device.LocalAddr), // Device 服务地址
 		AuthTargetAddr:    fmt.Sprintf("addrs:///%s", services.Auth.LocalAddr),
 		MessageTargetAddr: fmt.Sprintf("addrs:///%s", services.Message.LocalAddr),
+		// Message 内部服务单独监听，不与外部服务共用端口
+		MessageIntTargetAddr: fmt.Sprintf("addrs:///%s", services.Message.IntLocalAddr),
 	}
 }
 
@@ -119,6 +121,7 @@ type ServiceConfig struct {
 	User    UserEndpoints    `yaml:"user"`
 	Friend  FriendEndpoints  `yaml:"friend"`
 	Message MessageEndpoints `yaml:"message"`
+	Group   GroupEndpoints   `yaml:"group"`
 	File    FileEndpoints    `yaml:"file"`
 	Gateway GatewayEndpoints `yaml:"gateway"`
 }
@@ -129,12 +132,35 @@ type ConnectEndpoints struct {
 	RPCAddr   string `yaml:"rpc_addr"`   // RPC监听地址
 	TCPAddr   string `yaml:"tcp_addr"`   // TCP长连接监听地址
 	WSAddr    string `yaml:"ws_addr"`    // WebSocket长连接监听地址
+	AdminAddr string `yaml:"admin_addr"` // 内部管理监听地址，提供 /debug/vars 指标，仅应绑定内网地址；为空时不启动
+
+	HeartbeatInterval string `yaml:"heartbeat_interval"` // 客户端心跳间隔 (如 "30s")
+	IdleTimeout       string `yaml:"idle_timeout"`       // 连接空闲超时，超过该时间未收到心跳则断开；设备在线状态的 TTL 在此基础上再加一个心跳间隔
+
+	AckTimeout     string `yaml:"ack_timeout"`     // 消息推送等待送达确认的超时时间 (如 "5s")
+	AckMaxRetries  int    `yaml:"ack_max_retries"` // 未确认消息的最大重传次数
+	InflightWindow int    `yaml:"inflight_window"` // 单设备未确认消息的窗口大小
+	SyncPageSize   int    `yaml:"sync_page_size"`  // SYNC 每批下发的消息条数
+
+	WriteQueueSize      int    `yaml:"write_queue_size"`      // 单连接写队列容量
+	WriteOverflowPolicy string `yaml:"write_overflow_policy"` // 写队列溢出策略：close / drop_oldest / spill
+
+	Compression       bool `yaml:"compression"`        // 是否支持 WebSocket permessage-deflate 压缩
+	CompressThreshold int  `yaml:"compress_threshold"` // 数据包达到该字节数才压缩
+
+	SignalRateLimit int `yaml:"signal_rate_limit"` // 单连接每秒允许发送的瞬时信号数
+
+	DrainTimeout    string `yaml:"drain_timeout"`    // 停机排空的最长时间 (如 "30s")
+	ReconnectWindow string `yaml:"reconnect_window"` // 停机时客户端重连延迟的打散区间 (如 "10s")
 }
 
 // DeviceEndpoints 封装了Device服务的监听端点
 type DeviceEndpoints struct {
 	LocalAddr string `yaml:"local_addr"`
 	RPCAddr   string `yaml:"rpc_addr"`
+
+	// LoginPolicy 多端登录策略：每类设备（mobile/desktop/web）允许同时在线的数量，0 或未配置表示不限
+	LoginPolicy map[string]int `yaml:"login_policy"`
 }
 
 // UserEndpoints 封装了User服务的监听端点
@@ -149,12 +175,21 @@ type FriendEndpoints struct {
 	RPCAddr   string `yaml:"rpc_addr"`
 }
 
-// MessageEndpoints 封装了Message服务的监听端点
-type MessageEndpoints struct {
+// GroupEndpoints 封装了Group服务的监听端点
+type GroupEndpoints struct {
 	LocalAddr string `yaml:"local_addr"`
 	RPCAddr   string `yaml:"rpc_addr"`
 }
 
+// MessageEndpoints 封装了Message服务的监听端点
+type MessageEndpoints struct {
+	LocalAddr    string `yaml:"local_addr"`
+	RPCAddr      string `yaml:"rpc_addr"`
+	IntLocalAddr string `yaml:"int_local_addr"` // 内部服务暴露给其他服务的地址
+	IntRPCAddr   string `yaml:"int_rpc_addr"`   // 内部服务监听地址，仅绑定内网，不经过 JWT 认证
+	RecallWindow string `yaml:"recall_window"`  // 消息可撤回时间窗口 (如 "2m")
+}
+
 // FileEndpoints 封装了File服务的监听端点
 type FileEndpoints struct {
 	HTTPAddr string `yaml:"http_addr"`
@@ -162,11 +197,12 @@ type FileEndpoints struct {
 
 // GRPCClientConfig 封装所有 gRPC 服务的客户端目标地址
 type GRPCClientConfig struct {
-	ConnectTargetAddr string // Connect 服务的地址
-	DeviceTargetAddr  string // Device 服务的地址
-	MessageTargetAddr string // Message 服务的地址
-	RoomTargetAddr    string // Room 服务的地址
-	AuthTargetAddr    string // Auth 服务的地址
+	ConnectTargetAddr    string // Connect 服务的地址
+	DeviceTargetAddr     string // Device 服务的地址
+	MessageTargetAddr    string // Message 服务的地址
+	MessageIntTargetAddr string // Message 内部服务的地址
+	RoomTargetAddr       string // Room 服务的地址
+	AuthTargetAddr       string // Auth 服务的地址
 }
 
 // AuthEndpoints 封装了Auth服务的监听端点
@@ -0,0 +1,239 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: conversation.sql
+
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+const deleteConversationUsers = `-- name: DeleteConversationUsers :exec
+DELETE FROM user_conversation
+WHERE conversation_id = ?
+`
+
+// 删除会话下全部用户视图（解散群组时清理）
+func (q *Queries) DeleteConversationUsers(ctx context.Context, conversationID string) error {
+	_, err := q.db.ExecContext(ctx, deleteConversationUsers, conversationID)
+	return err
+}
+
+const deleteUserConversation = `-- name: DeleteUserConversation :exec
+DELETE FROM user_conversation
+WHERE user_id = ? AND conversation_id = ?
+`
+
+type DeleteUserConversationParams struct {
+	UserID         uint64 `json:"user_id"`
+	ConversationID string `json:"conversation_id"`
+}
+
+// 删除用户的会话视图（退群/被移出群时清理）
+func (q *Queries) DeleteUserConversation(ctx context.Context, arg DeleteUserConversationParams) error {
+	_, err := q.db.ExecContext(ctx, deleteUserConversation, arg.UserID, arg.ConversationID)
+	return err
+}
+
+const listPinnedUserConversations = `-- name: ListPinnedUserConversations :many
+SELECT uc.conversation_id, uc.last_read_seq, uc.unread_count, uc.is_muted, uc.is_pinned,
+       uc.mention_count, uc.first_unread_mention_seq,
+       c.type, c.participants, c.last_message_id, c.last_seq, c.updated_at
+FROM user_conversation uc
+JOIN conversation c ON c.conversation_id = uc.conversation_id
+WHERE uc.user_id = ? AND uc.is_pinned = 1
+ORDER BY c.updated_at DESC, c.conversation_id DESC
+LIMIT ?
+`
+
+type ListPinnedUserConversationsParams struct {
+	UserID uint64 `json:"user_id"`
+	Limit  int32  `json:"limit"`
+}
+
+type ListPinnedUserConversationsRow struct {
+	ConversationID        string          `json:"conversation_id"`
+	LastReadSeq           sql.NullInt64   `json:"last_read_seq"`
+	UnreadCount           sql.NullInt32   `json:"unread_count"`
+	IsMuted               sql.NullBool    `json:"is_muted"`
+	IsPinned              sql.NullBool    `json:"is_pinned"`
+	MentionCount          int32           `json:"mention_count"`
+	FirstUnreadMentionSeq int64           `json:"first_unread_mention_seq"`
+	Type                  int8            `json:"type"`
+	Participants          json.RawMessage `json:"participants"`
+	LastMessageID         sql.NullString  `json:"last_message_id"`
+	LastSeq               sql.NullInt64   `json:"last_seq"`
+	UpdatedAt             time.Time       `json:"updated_at"`
+}
+
+// 获取用户置顶的会话（按最后活跃时间倒序）
+func (q *Queries) ListPinnedUserConversations(ctx context.Context, arg ListPinnedUserConversationsParams) ([]ListPinnedUserConversationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPinnedUserConversations, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPinnedUserConversationsRow{}
+	for rows.Next() {
+		var i ListPinnedUserConversationsRow
+		if err := rows.Scan(
+			&i.ConversationID,
+			&i.LastReadSeq,
+			&i.UnreadCount,
+			&i.IsMuted,
+			&i.IsPinned,
+			&i.MentionCount,
+			&i.FirstUnreadMentionSeq,
+			&i.Type,
+			&i.Participants,
+			&i.LastMessageID,
+			&i.LastSeq,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserConversations = `-- name: ListUserConversations :many
+SELECT uc.conversation_id, uc.last_read_seq, uc.unread_count, uc.is_muted, uc.is_pinned,
+       uc.mention_count, uc.first_unread_mention_seq,
+       c.type, c.participants, c.last_message_id, c.last_seq, c.updated_at
+FROM user_conversation uc
+JOIN conversation c ON c.conversation_id = uc.conversation_id
+WHERE uc.user_id = ? AND uc.is_pinned = 0
+  AND (c.updated_at < ?
+       OR (c.updated_at = ? AND c.conversation_id < ?))
+ORDER BY c.updated_at DESC, c.conversation_id DESC
+LIMIT ?
+`
+
+type ListUserConversationsParams struct {
+	UserID               uint64    `json:"user_id"`
+	CursorUpdatedAt      time.Time `json:"cursor_updated_at"`
+	CursorConversationID string    `json:"cursor_conversation_id"`
+	Limit                int32     `json:"limit"`
+}
+
+type ListUserConversationsRow struct {
+	ConversationID        string          `json:"conversation_id"`
+	LastReadSeq           sql.NullInt64   `json:"last_read_seq"`
+	UnreadCount           sql.NullInt32   `json:"unread_count"`
+	IsMuted               sql.NullBool    `json:"is_muted"`
+	IsPinned              sql.NullBool    `json:"is_pinned"`
+	MentionCount          int32           `json:"mention_count"`
+	FirstUnreadMentionSeq int64           `json:"first_unread_mention_seq"`
+	Type                  int8            `json:"type"`
+	Participants          json.RawMessage `json:"participants"`
+	LastMessageID         sql.NullString  `json:"last_message_id"`
+	LastSeq               sql.NullInt64   `json:"last_seq"`
+	UpdatedAt             time.Time       `json:"updated_at"`
+}
+
+// 获取用户未置顶的会话，按 (updated_at, conversation_id) 键集分页
+func (q *Queries) ListUserConversations(ctx context.Context, arg ListUserConversationsParams) ([]ListUserConversationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserConversations,
+		arg.UserID,
+		arg.CursorUpdatedAt,
+		arg.CursorUpdatedAt,
+		arg.CursorConversationID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserConversationsRow{}
+	for rows.Next() {
+		var i ListUserConversationsRow
+		if err := rows.Scan(
+			&i.ConversationID,
+			&i.LastReadSeq,
+			&i.UnreadCount,
+			&i.IsMuted,
+			&i.IsPinned,
+			&i.MentionCount,
+			&i.FirstUnreadMentionSeq,
+			&i.Type,
+			&i.Participants,
+			&i.LastMessageID,
+			&i.LastSeq,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserConversationsSince = `-- name: ListUserConversationsSince :many
+SELECT c.conversation_id,
+       CAST(COALESCE((SELECT mi.seq FROM message_index mi
+                      WHERE mi.conversation_id = c.conversation_id AND mi.created_at < ?
+                      ORDER BY mi.seq DESC
+                      LIMIT 1), 0) AS SIGNED) AS start_seq
+FROM user_conversation uc
+JOIN conversation c ON c.conversation_id = uc.conversation_id
+WHERE uc.user_id = ? AND c.updated_at >= ?
+ORDER BY c.updated_at DESC, c.conversation_id DESC
+LIMIT ?
+`
+
+type ListUserConversationsSinceParams struct {
+	Since  time.Time `json:"since"`
+	UserID uint64    `json:"user_id"`
+	Limit  int32     `json:"limit"`
+}
+
+type ListUserConversationsSinceRow struct {
+	ConversationID string `json:"conversation_id"`
+	StartSeq       int64  `json:"start_seq"`
+}
+
+// 全局收件箱同步：获取用户自 since 起有更新的会话（最近更新的优先），以及各会话在 since 之前的最大 seq
+func (q *Queries) ListUserConversationsSince(ctx context.Context, arg ListUserConversationsSinceParams) ([]ListUserConversationsSinceRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserConversationsSince,
+		arg.Since,
+		arg.UserID,
+		arg.Since,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserConversationsSinceRow{}
+	for rows.Next() {
+		var i ListUserConversationsSinceRow
+		if err := rows.Scan(&i.ConversationID, &i.StartSeq); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -8,6 +8,7 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 )
 
@@ -103,8 +104,19 @@ func (q *Queries) DeleteGroupUser(ctx context.Context, arg DeleteGroupUserParams
 	return err
 }
 
+const deleteGroupUsers = `-- name: DeleteGroupUsers :exec
+DELETE FROM ` + "`" + `group_user` + "`" + `
+WHERE group_id = ?
+`
+
+// 移除群组全部成员（解散群组）
+func (q *Queries) DeleteGroupUsers(ctx context.Context, groupID uint64) error {
+	_, err := q.db.ExecContext(ctx, deleteGroupUsers, groupID)
+	return err
+}
+
 const getGroup = `-- name: GetGroup :one
-SELECT id, created_at, updated_at, name, avatar_url, introduction, user_num, extra FROM ` + "`" + `group` + "`" + ` 
+SELECT id, created_at, updated_at, name, avatar_url, introduction, user_num, extra, mute_all_until FROM ` + "`" + `group` + "`" + ` 
 WHERE id = ? LIMIT 1
 `
 
@@ -121,12 +133,13 @@ func (q *Queries) GetGroup(ctx context.Context, id uint64) (Group, error) {
 		&i.Introduction,
 		&i.UserNum,
 		&i.Extra,
+		&i.MuteAllUntil,
 	)
 	return i, err
 }
 
 const getGroupUser = `-- name: GetGroupUser :one
-SELECT group_id, user_id, created_at, updated_at, member_type, remarks, extra, status FROM ` + "`" + `group_user` + "`" + ` 
+SELECT group_id, user_id, created_at, updated_at, remarks, extra, status, muted_until, member_type FROM ` + "`" + `group_user` + "`" + ` 
 WHERE group_id = ? AND user_id = ? 
 LIMIT 1
 `
@@ -145,18 +158,19 @@ func (q *Queries) GetGroupUser(ctx context.Context, arg GetGroupUserParams) (Gro
 		&i.UserID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
-		&i.MemberType,
 		&i.Remarks,
 		&i.Extra,
 		&i.Status,
+		&i.MutedUntil,
+		&i.MemberType,
 	)
 	return i, err
 }
 
 const getGroupUsers = `-- name: GetGroupUsers :many
-SELECT group_id, user_id, created_at, updated_at, member_type, remarks, extra, status FROM ` + "`" + `group_user` + "`" + ` 
+SELECT group_id, user_id, created_at, updated_at, remarks, extra, status, muted_until, member_type FROM ` + "`" + `group_user` + "`" + ` 
 WHERE group_id = ?
-ORDER BY member_type ASC, created_at ASC
+ORDER BY FIELD(member_type, 3, 1, 2), created_at ASC
 `
 
 // 获取群组所有成员
@@ -174,10 +188,11 @@ func (q *Queries) GetGroupUsers(ctx context.Context, groupID uint64) ([]GroupUse
 			&i.UserID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.MemberType,
 			&i.Remarks,
 			&i.Extra,
 			&i.Status,
+			&i.MutedUntil,
+			&i.MemberType,
 		); err != nil {
 			return nil, err
 		}
@@ -193,7 +208,7 @@ func (q *Queries) GetGroupUsers(ctx context.Context, groupID uint64) ([]GroupUse
 }
 
 const getUserGroups = `-- name: GetUserGroups :many
-SELECT group_id, user_id, created_at, updated_at, member_type, remarks, extra, status FROM ` + "`" + `group_user` + "`" + ` 
+SELECT group_id, user_id, created_at, updated_at, remarks, extra, status, muted_until, member_type FROM ` + "`" + `group_user` + "`" + ` 
 WHERE user_id = ?
 ORDER BY created_at DESC
 `
@@ -213,10 +228,11 @@ func (q *Queries) GetUserGroups(ctx context.Context, userID uint64) ([]GroupUser
 			&i.UserID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.MemberType,
 			&i.Remarks,
 			&i.Extra,
 			&i.Status,
+			&i.MutedUntil,
+			&i.MemberType,
 		); err != nil {
 			return nil, err
 		}
@@ -232,7 +248,7 @@ func (q *Queries) GetUserGroups(ctx context.Context, userID uint64) ([]GroupUser
 }
 
 const listGroups = `-- name: ListGroups :many
-SELECT id, created_at, updated_at, name, avatar_url, introduction, user_num, extra FROM ` + "`" + `group` + "`" + ` 
+SELECT id, created_at, updated_at, name, avatar_url, introduction, user_num, extra, mute_all_until FROM ` + "`" + `group` + "`" + ` 
 ORDER BY created_at DESC 
 LIMIT ? OFFSET ?
 `
@@ -261,6 +277,56 @@ func (q *Queries) ListGroups(ctx context.Context, arg ListGroupsParams) ([]Group
 			&i.Introduction,
 			&i.UserNum,
 			&i.Extra,
+			&i.MuteAllUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGroupsByIDs = `-- name: ListGroupsByIDs :many
+SELECT id, created_at, updated_at, name, avatar_url, introduction, user_num, extra, mute_all_until FROM ` + "`" + `group` + "`" + `
+WHERE id IN (/*SLICE:ids*/?)
+`
+
+// 根据群组ID批量获取群组信息
+func (q *Queries) ListGroupsByIDs(ctx context.Context, ids []uint64) ([]Group, error) {
+	query := listGroupsByIDs
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Group{}
+	for rows.Next() {
+		var i Group
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.AvatarUrl,
+			&i.Introduction,
+			&i.UserNum,
+			&i.Extra,
+			&i.MuteAllUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -275,6 +341,66 @@ func (q *Queries) ListGroups(ctx context.Context, arg ListGroupsParams) ([]Group
 	return items, nil
 }
 
+const setGroupMuteAll = `-- name: SetGroupMuteAll :exec
+UPDATE ` + "`" + `group` + "`" + `
+SET updated_at = ?, mute_all_until = ?
+WHERE id = ?
+`
+
+type SetGroupMuteAllParams struct {
+	UpdatedAt    time.Time    `json:"updated_at"`
+	MuteAllUntil sql.NullTime `json:"mute_all_until"`
+	ID           uint64       `json:"id"`
+}
+
+// 设置/清除全员禁言（mute_all_until 为 NULL 表示解除）
+func (q *Queries) SetGroupMuteAll(ctx context.Context, arg SetGroupMuteAllParams) error {
+	_, err := q.db.ExecContext(ctx, setGroupMuteAll, arg.UpdatedAt, arg.MuteAllUntil, arg.ID)
+	return err
+}
+
+const setGroupUserMute = `-- name: SetGroupUserMute :exec
+UPDATE ` + "`" + `group_user` + "`" + `
+SET updated_at = ?, muted_until = ?
+WHERE group_id = ? AND user_id = ?
+`
+
+type SetGroupUserMuteParams struct {
+	UpdatedAt  time.Time    `json:"updated_at"`
+	MutedUntil sql.NullTime `json:"muted_until"`
+	GroupID    uint64       `json:"group_id"`
+	UserID     uint64       `json:"user_id"`
+}
+
+// 设置/清除成员禁言（muted_until 为 NULL 表示解除）
+func (q *Queries) SetGroupUserMute(ctx context.Context, arg SetGroupUserMuteParams) error {
+	_, err := q.db.ExecContext(ctx, setGroupUserMute,
+		arg.UpdatedAt,
+		arg.MutedUntil,
+		arg.GroupID,
+		arg.UserID,
+	)
+	return err
+}
+
+const syncGroupUserNum = `-- name: SyncGroupUserNum :exec
+UPDATE ` + "`" + `group` + "`" + `
+SET updated_at = ?,
+    user_num = (SELECT COUNT(*) FROM ` + "`" + `group_user` + "`" + ` WHERE ` + "`" + `group_user` + "`" + `.group_id = ?)
+WHERE id = ?
+`
+
+type SyncGroupUserNumParams struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	ID        uint64    `json:"id"`
+}
+
+// 按 group_user 实际成员数回写群组人数
+func (q *Queries) SyncGroupUserNum(ctx context.Context, arg SyncGroupUserNumParams) error {
+	_, err := q.db.ExecContext(ctx, syncGroupUserNum, arg.UpdatedAt, arg.ID, arg.ID)
+	return err
+}
+
 const updateGroup = `-- name: UpdateGroup :exec
 UPDATE ` + "`" + `group` + "`" + ` 
 SET updated_at = ?, name = ?, avatar_url = ?, introduction = ?, extra = ?
@@ -8,23 +8,70 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"strings"
 )
 
+const deleteReadMentions = `-- name: DeleteReadMentions :exec
+DELETE FROM user_mention
+WHERE user_id = ? AND conversation_id = ? AND seq <= ?
+`
+
+type DeleteReadMentionsParams struct {
+	UserID         uint64 `json:"user_id"`
+	ConversationID string `json:"conversation_id"`
+	Seq            int64  `json:"seq"`
+}
+
+// 删除已读位置及之前的 @ 明细
+func (q *Queries) DeleteReadMentions(ctx context.Context, arg DeleteReadMentionsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteReadMentions, arg.UserID, arg.ConversationID, arg.Seq)
+	return err
+}
+
+const getConversation = `-- name: GetConversation :one
+SELECT conversation_id, type, participants, last_message_id, last_seq, created_at, updated_at FROM conversation
+WHERE conversation_id = ? LIMIT 1
+`
+
+func (q *Queries) GetConversation(ctx context.Context, conversationID string) (Conversation, error) {
+	row := q.db.QueryRowContext(ctx, getConversation, conversationID)
+	var i Conversation
+	err := row.Scan(
+		&i.ConversationID,
+		&i.Type,
+		&i.Participants,
+		&i.LastMessageID,
+		&i.LastSeq,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getConversationMessages = `-- name: GetConversationMessages :many
 SELECT message_id, conversation_id, sender_id, recipient_id, message_type, seq, reply_to_msg_id, status, created_at, updated_at FROM message_index
 WHERE conversation_id = ?
+  AND seq > ?
+  AND seq < ?
 ORDER BY seq DESC
-LIMIT ? OFFSET ?
+LIMIT ?
 `
 
 type GetConversationMessagesParams struct {
 	ConversationID string `json:"conversation_id"`
+	AfterSeq       int64  `json:"after_seq"`
+	BeforeSeq      int64  `json:"before_seq"`
 	Limit          int32  `json:"limit"`
-	Offset         int32  `json:"offset"`
 }
 
+// 按 seq 游标向前翻页：取 (after_seq, before_seq) 区间内最新的若干条，seq 倒序
 func (q *Queries) GetConversationMessages(ctx context.Context, arg GetConversationMessagesParams) ([]MessageIndex, error) {
-	rows, err := q.db.QueryContext(ctx, getConversationMessages, arg.ConversationID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, getConversationMessages,
+		arg.ConversationID,
+		arg.AfterSeq,
+		arg.BeforeSeq,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +104,94 @@ func (q *Queries) GetConversationMessages(ctx context.Context, arg GetConversati
 	return items, nil
 }
 
+const getConversationMessagesAfter = `-- name: GetConversationMessagesAfter :many
+SELECT message_id, conversation_id, sender_id, recipient_id, message_type, seq, reply_to_msg_id, status, created_at, updated_at FROM message_index
+WHERE conversation_id = ? AND seq > ?
+ORDER BY seq ASC
+LIMIT ?
+`
+
+type GetConversationMessagesAfterParams struct {
+	ConversationID string `json:"conversation_id"`
+	Seq            int64  `json:"seq"`
+	Limit          int32  `json:"limit"`
+}
+
+// 按 seq 游标向后追赶：取 after_seq 之后最早的若干条，seq 正序
+func (q *Queries) GetConversationMessagesAfter(ctx context.Context, arg GetConversationMessagesAfterParams) ([]MessageIndex, error) {
+	rows, err := q.db.QueryContext(ctx, getConversationMessagesAfter, arg.ConversationID, arg.Seq, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MessageIndex{}
+	for rows.Next() {
+		var i MessageIndex
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.ConversationID,
+			&i.SenderID,
+			&i.RecipientID,
+			&i.MessageType,
+			&i.Seq,
+			&i.ReplyToMsgID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLastReadSeq = `-- name: GetLastReadSeq :one
+SELECT last_read_seq FROM user_conversation
+WHERE user_id = ? AND conversation_id = ?
+`
+
+type GetLastReadSeqParams struct {
+	UserID         uint64 `json:"user_id"`
+	ConversationID string `json:"conversation_id"`
+}
+
+func (q *Queries) GetLastReadSeq(ctx context.Context, arg GetLastReadSeqParams) (sql.NullInt64, error) {
+	row := q.db.QueryRowContext(ctx, getLastReadSeq, arg.UserID, arg.ConversationID)
+	var last_read_seq sql.NullInt64
+	err := row.Scan(&last_read_seq)
+	return last_read_seq, err
+}
+
+const getMessageIndex = `-- name: GetMessageIndex :one
+SELECT message_id, conversation_id, sender_id, recipient_id, message_type, seq, reply_to_msg_id, status, created_at, updated_at FROM message_index
+WHERE message_id = ? LIMIT 1
+`
+
+func (q *Queries) GetMessageIndex(ctx context.Context, messageID string) (MessageIndex, error) {
+	row := q.db.QueryRowContext(ctx, getMessageIndex, messageID)
+	var i MessageIndex
+	err := row.Scan(
+		&i.MessageID,
+		&i.ConversationID,
+		&i.SenderID,
+		&i.RecipientID,
+		&i.MessageType,
+		&i.Seq,
+		&i.ReplyToMsgID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getUnreadByUserAndConversation = `-- name: GetUnreadByUserAndConversation :one
 SELECT unread_count FROM user_conversation
 WHERE user_id = ? AND conversation_id = ?
@@ -74,6 +209,59 @@ func (q *Queries) GetUnreadByUserAndConversation(ctx context.Context, arg GetUnr
 	return unread_count, err
 }
 
+const incrGroupUnreadExceptSender = `-- name: IncrGroupUnreadExceptSender :exec
+UPDATE user_conversation uc
+SET uc.unread_count = uc.unread_count + 1, uc.updated_at = CURRENT_TIMESTAMP
+WHERE uc.conversation_id = ?
+  AND uc.user_id <> ?
+  AND uc.user_id IN (SELECT gu.user_id FROM group_user gu WHERE gu.group_id = ?)
+`
+
+type IncrGroupUnreadExceptSenderParams struct {
+	ConversationID string `json:"conversation_id"`
+	SenderID       uint64 `json:"sender_id"`
+	GroupID        uint64 `json:"group_id"`
+}
+
+// 群内除发送者外的成员未读 +1
+func (q *Queries) IncrGroupUnreadExceptSender(ctx context.Context, arg IncrGroupUnreadExceptSenderParams) error {
+	_, err := q.db.ExecContext(ctx, incrGroupUnreadExceptSender, arg.ConversationID, arg.SenderID, arg.GroupID)
+	return err
+}
+
+const incrMentionOnUsers = `-- name: IncrMentionOnUsers :exec
+UPDATE user_conversation
+SET mention_count = mention_count + 1,
+    first_unread_mention_seq = IF(first_unread_mention_seq = 0, CAST(? AS SIGNED), first_unread_mention_seq),
+    updated_at = CURRENT_TIMESTAMP
+WHERE conversation_id = ?
+  AND user_id IN (/*SLICE:user_ids*/?)
+`
+
+type IncrMentionOnUsersParams struct {
+	Seq            int64    `json:"seq"`
+	ConversationID string   `json:"conversation_id"`
+	UserIds        []uint64 `json:"user_ids"`
+}
+
+// 被 @ 的成员未读 @ 数 +1，并记录首条未读 @ 的 seq
+func (q *Queries) IncrMentionOnUsers(ctx context.Context, arg IncrMentionOnUsersParams) error {
+	query := incrMentionOnUsers
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Seq)
+	queryParams = append(queryParams, arg.ConversationID)
+	if len(arg.UserIds) > 0 {
+		for _, v := range arg.UserIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:user_ids*/?", strings.Repeat(",?", len(arg.UserIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:user_ids*/?", "NULL", 1)
+	}
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
 const incrUnreadOnRecipient = `-- name: IncrUnreadOnRecipient :exec
 UPDATE user_conversation
 SET unread_count = unread_count + 1, updated_at = CURRENT_TIMESTAMP
@@ -123,20 +311,166 @@ func (q *Queries) InsertMessageIndex(ctx context.Context, arg InsertMessageIndex
 	return err
 }
 
+const insertUserMentions = `-- name: InsertUserMentions :exec
+INSERT IGNORE INTO user_mention (user_id, conversation_id, seq)
+SELECT uc.user_id, uc.conversation_id, CAST(? AS SIGNED) FROM user_conversation uc
+WHERE uc.conversation_id = ?
+  AND uc.user_id IN (/*SLICE:user_ids*/?)
+`
+
+type InsertUserMentionsParams struct {
+	Seq            int64    `json:"seq"`
+	ConversationID string   `json:"conversation_id"`
+	UserIds        []uint64 `json:"user_ids"`
+}
+
+// 记录被 @ 成员的 @ 明细，仅记录仍在会话中的成员
+func (q *Queries) InsertUserMentions(ctx context.Context, arg InsertUserMentionsParams) error {
+	query := insertUserMentions
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Seq)
+	queryParams = append(queryParams, arg.ConversationID)
+	if len(arg.UserIds) > 0 {
+		for _, v := range arg.UserIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:user_ids*/?", strings.Repeat(",?", len(arg.UserIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:user_ids*/?", "NULL", 1)
+	}
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
+const listMessageIndexByIDs = `-- name: ListMessageIndexByIDs :many
+SELECT message_id, conversation_id, sender_id, recipient_id, message_type, seq, reply_to_msg_id, status, created_at, updated_at FROM message_index
+WHERE message_id IN (/*SLICE:message_ids*/?)
+`
+
+// 按 message_id 批量获取消息索引（用于组装引用消息）
+func (q *Queries) ListMessageIndexByIDs(ctx context.Context, messageIds []string) ([]MessageIndex, error) {
+	query := listMessageIndexByIDs
+	var queryParams []interface{}
+	if len(messageIds) > 0 {
+		for _, v := range messageIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:message_ids*/?", strings.Repeat(",?", len(messageIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:message_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MessageIndex{}
+	for rows.Next() {
+		var i MessageIndex
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.ConversationID,
+			&i.SenderID,
+			&i.RecipientID,
+			&i.MessageType,
+			&i.Seq,
+			&i.ReplyToMsgID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMessageSenders = `-- name: ListMessageSenders :many
+SELECT DISTINCT sender_id FROM message_index
+WHERE conversation_id = ?
+  AND seq > ?
+  AND seq <= ?
+`
+
+type ListMessageSendersParams struct {
+	ConversationID string `json:"conversation_id"`
+	AfterSeq       int64  `json:"after_seq"`
+	ToSeq          int64  `json:"to_seq"`
+}
+
+// 获取会话 seq 区间 (after_seq, to_seq] 内消息的发送者（去重），用于群聊已读回执
+func (q *Queries) ListMessageSenders(ctx context.Context, arg ListMessageSendersParams) ([]uint64, error) {
+	rows, err := q.db.QueryContext(ctx, listMessageSenders, arg.ConversationID, arg.AfterSeq, arg.ToSeq)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uint64{}
+	for rows.Next() {
+		var sender_id uint64
+		if err := rows.Scan(&sender_id); err != nil {
+			return nil, err
+		}
+		items = append(items, sender_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markRead = `-- name: MarkRead :exec
 UPDATE user_conversation
-SET last_read_seq = GREATEST(last_read_seq, ?), unread_count = 0, updated_at = CURRENT_TIMESTAMP
-WHERE user_id = ? AND conversation_id = ?
+SET last_read_seq = GREATEST(last_read_seq, CAST(? AS SIGNED)), unread_count = 0,
+    mention_count = (
+        SELECT COUNT(*) FROM user_mention m
+        WHERE m.user_id = user_conversation.user_id
+          AND m.conversation_id = user_conversation.conversation_id
+    ),
+    first_unread_mention_seq = COALESCE((
+        SELECT MIN(m.seq) FROM user_mention m
+        WHERE m.user_id = user_conversation.user_id
+          AND m.conversation_id = user_conversation.conversation_id
+    ), 0),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_conversation.user_id = ? AND user_conversation.conversation_id = ?
 `
 
 type MarkReadParams struct {
-	GREATEST       interface{} `json:"GREATEST"`
-	UserID         uint64      `json:"user_id"`
-	ConversationID string      `json:"conversation_id"`
+	ReadSeq        int64  `json:"read_seq"`
+	UserID         uint64 `json:"user_id"`
+	ConversationID string `json:"conversation_id"`
 }
 
+// 推进已读位置并清零未读；未读 @ 按剩余的 @ 明细重新统计，需先执行 DeleteReadMentions
 func (q *Queries) MarkRead(ctx context.Context, arg MarkReadParams) error {
-	_, err := q.db.ExecContext(ctx, markRead, arg.GREATEST, arg.UserID, arg.ConversationID)
+	_, err := q.db.ExecContext(ctx, markRead, arg.ReadSeq, arg.UserID, arg.ConversationID)
+	return err
+}
+
+const updateMessageIndexStatus = `-- name: UpdateMessageIndexStatus :exec
+UPDATE message_index
+SET status = ?, updated_at = CURRENT_TIMESTAMP
+WHERE message_id = ?
+`
+
+type UpdateMessageIndexStatusParams struct {
+	Status    sql.NullInt16 `json:"status"`
+	MessageID string        `json:"message_id"`
+}
+
+func (q *Queries) UpdateMessageIndexStatus(ctx context.Context, arg UpdateMessageIndexStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updateMessageIndexStatus, arg.Status, arg.MessageID)
 	return err
 }
 
@@ -165,6 +499,43 @@ func (q *Queries) UpsertConversationOnSend(ctx context.Context, arg UpsertConver
 	return err
 }
 
+const upsertGroupConversationOnSend = `-- name: UpsertGroupConversationOnSend :exec
+INSERT INTO conversation (conversation_id, type, participants, last_message_id, last_seq)
+VALUES (?, 2, JSON_ARRAY(), ?, ?)
+ON DUPLICATE KEY UPDATE last_message_id = VALUES(last_message_id), last_seq = VALUES(last_seq), updated_at = CURRENT_TIMESTAMP
+`
+
+type UpsertGroupConversationOnSendParams struct {
+	ConversationID string         `json:"conversation_id"`
+	LastMessageID  sql.NullString `json:"last_message_id"`
+	LastSeq        sql.NullInt64  `json:"last_seq"`
+}
+
+// 群聊会话：参与者以 group_user 为准，participants 留空
+func (q *Queries) UpsertGroupConversationOnSend(ctx context.Context, arg UpsertGroupConversationOnSendParams) error {
+	_, err := q.db.ExecContext(ctx, upsertGroupConversationOnSend, arg.ConversationID, arg.LastMessageID, arg.LastSeq)
+	return err
+}
+
+const upsertGroupUserConversationsOnSend = `-- name: UpsertGroupUserConversationsOnSend :exec
+INSERT INTO user_conversation (user_id, conversation_id, last_read_seq, unread_count, is_muted, is_pinned)
+SELECT gu.user_id, ?, 0, 0, 0, 0
+FROM group_user gu
+WHERE gu.group_id = ?
+ON DUPLICATE KEY UPDATE user_conversation.updated_at = CURRENT_TIMESTAMP
+`
+
+type UpsertGroupUserConversationsOnSendParams struct {
+	ConversationID string `json:"conversation_id"`
+	GroupID        uint64 `json:"group_id"`
+}
+
+// 为群内所有成员确保 user_conversation 存在
+func (q *Queries) UpsertGroupUserConversationsOnSend(ctx context.Context, arg UpsertGroupUserConversationsOnSendParams) error {
+	_, err := q.db.ExecContext(ctx, upsertGroupUserConversationsOnSend, arg.ConversationID, arg.GroupID)
+	return err
+}
+
 const upsertUserConversationOnSend = `-- name: UpsertUserConversationOnSend :exec
 INSERT INTO user_conversation (user_id, conversation_id, last_read_seq, unread_count, is_muted, is_pinned)
 VALUES (?, ?, 0, 0, 0, 0)
@@ -157,6 +157,8 @@ type Group struct {
 	UserNum int32 `json:"user_num"`
 	// 附加属性
 	Extra string `json:"extra"`
+	// 全员禁言截止时间
+	MuteAllUntil sql.NullTime `json:"mute_all_until"`
 }
 
 // 群组成员
@@ -169,14 +171,16 @@ type GroupUser struct {
 	CreatedAt time.Time `json:"created_at"`
 	// 更新时间
 	UpdatedAt time.Time `json:"updated_at"`
-	// 成员类型，1：管理员；2：普通成员
-	MemberType int8 `json:"member_type"`
 	// 备注
 	Remarks string `json:"remarks"`
 	// 附加属性
 	Extra string `json:"extra"`
 	// 状态
 	Status int8 `json:"status"`
+	// 成员禁言截止时间
+	MutedUntil sql.NullTime `json:"muted_until"`
+	// 成员类型，1：管理员；2：普通成员；3：群主
+	MemberType int8 `json:"member_type"`
 }
 
 // 消息
@@ -301,6 +305,22 @@ type UserConversation struct {
 	IsPinned sql.NullBool `json:"is_pinned"`
 	// 更新时间
 	UpdatedAt sql.NullTime `json:"updated_at"`
+	// 未读@数
+	MentionCount int32 `json:"mention_count"`
+	// 首条未读@消息序列号，0 表示无
+	FirstUnreadMentionSeq int64 `json:"first_unread_mention_seq"`
+}
+
+// 用户未读@
+type UserMention struct {
+	// 被@的用户ID
+	UserID uint64 `json:"user_id"`
+	// 会话ID
+	ConversationID string `json:"conversation_id"`
+	// @消息序列号
+	Seq int64 `json:"seq"`
+	// 创建时间
+	CreatedAt sql.NullTime `json:"created_at"`
 }
 
 // 用户消息
@@ -36,6 +36,8 @@ type Querier interface {
 	CreateUserByUsername(ctx context.Context, arg CreateUserByUsernameParams) (sql.Result, error)
 	// 创建用户消息关联
 	CreateUserMessage(ctx context.Context, arg CreateUserMessageParams) error
+	// 删除会话下全部用户视图（解散群组时清理）
+	DeleteConversationUsers(ctx context.Context, conversationID string) error
 	// 删除设备
 	DeleteDevice(ctx context.Context, id uint64) error
 	// 删除好友关系
@@ -46,17 +48,27 @@ type Querier interface {
 	DeleteGroup(ctx context.Context, id uint64) error
 	// 移除群组成员
 	DeleteGroupUser(ctx context.Context, arg DeleteGroupUserParams) error
+	// 移除群组全部成员（解散群组）
+	DeleteGroupUsers(ctx context.Context, groupID uint64) error
 	// 删除消息
 	DeleteMessage(ctx context.Context, id uint64) error
+	// 删除已读位置及之前的 @ 明细
+	DeleteReadMentions(ctx context.Context, arg DeleteReadMentionsParams) error
 	// 删除序列号记录
 	DeleteSeq(ctx context.Context, arg DeleteSeqParams) error
 	// 删除用户
 	DeleteUser(ctx context.Context, id uint64) error
+	// 删除用户的会话视图（退群/被移出群时清理）
+	DeleteUserConversation(ctx context.Context, arg DeleteUserConversationParams) error
 	// 删除用户消息关联
 	DeleteUserMessage(ctx context.Context, arg DeleteUserMessageParams) error
 	// 获取被屏蔽的好友
 	GetBlockedFriends(ctx context.Context, userID uint64) ([]Friend, error)
+	GetConversation(ctx context.Context, conversationID string) (Conversation, error)
+	// 按 seq 游标向前翻页：取 (after_seq, before_seq) 区间内最新的若干条，seq 倒序
 	GetConversationMessages(ctx context.Context, arg GetConversationMessagesParams) ([]MessageIndex, error)
+	// 按 seq 游标向后追赶：取 after_seq 之后最早的若干条，seq 正序
+	GetConversationMessagesAfter(ctx context.Context, arg GetConversationMessagesAfterParams) ([]MessageIndex, error)
 	// 根据设备ID获取设备信息
 	GetDevice(ctx context.Context, id uint64) (Device, error)
 	// 根据用户ID和设备类型获取设备
@@ -73,8 +85,10 @@ type Querier interface {
 	GetGroupUser(ctx context.Context, arg GetGroupUserParams) (GroupUser, error)
 	// 获取群组所有成员
 	GetGroupUsers(ctx context.Context, groupID uint64) ([]GroupUser, error)
+	GetLastReadSeq(ctx context.Context, arg GetLastReadSeqParams) (sql.NullInt64, error)
 	// 根据消息ID获取消息
 	GetMessage(ctx context.Context, id uint64) (Message, error)
+	GetMessageIndex(ctx context.Context, messageID string) (MessageIndex, error)
 	// 获取在线设备列表
 	GetOnlineDevices(ctx context.Context) ([]Device, error)
 	// 获取或创建序列号（使用 INSERT ... ON DUPLICATE KEY UPDATE）
@@ -118,22 +132,49 @@ type Querier interface {
 	GetUserMessages(ctx context.Context, arg GetUserMessagesParams) ([]GetUserMessagesRow, error)
 	// 忽略好友申请
 	IgnoreFriendRequest(ctx context.Context, arg IgnoreFriendRequestParams) error
+	// 群内除发送者外的成员未读 +1
+	IncrGroupUnreadExceptSender(ctx context.Context, arg IncrGroupUnreadExceptSenderParams) error
+	// 被 @ 的成员未读 @ 数 +1，并记录首条未读 @ 的 seq
+	IncrMentionOnUsers(ctx context.Context, arg IncrMentionOnUsersParams) error
 	IncrUnreadOnRecipient(ctx context.Context, arg IncrUnreadOnRecipientParams) error
 	// 递增序列号
 	IncrementSeq(ctx context.Context, arg IncrementSeqParams) error
 	InsertMessageIndex(ctx context.Context, arg InsertMessageIndexParams) error
 	InsertOutboxEvent(ctx context.Context, arg InsertOutboxEventParams) error
+	// 记录被 @ 成员的 @ 明细，仅记录仍在会话中的成员
+	InsertUserMentions(ctx context.Context, arg InsertUserMentionsParams) error
 	// 获取群组列表
 	ListGroups(ctx context.Context, arg ListGroupsParams) ([]Group, error)
+	// 根据群组ID批量获取群组信息
+	ListGroupsByIDs(ctx context.Context, ids []uint64) ([]Group, error)
+	// 按 message_id 批量获取消息索引（用于组装引用消息）
+	ListMessageIndexByIDs(ctx context.Context, messageIds []string) ([]MessageIndex, error)
+	// 获取会话 seq 区间 (after_seq, to_seq] 内消息的发送者（去重），用于群聊已读回执
+	ListMessageSenders(ctx context.Context, arg ListMessageSendersParams) ([]uint64, error)
+	// 获取用户置顶的会话（按最后活跃时间倒序）
+	ListPinnedUserConversations(ctx context.Context, arg ListPinnedUserConversationsParams) ([]ListPinnedUserConversationsRow, error)
+	// 获取用户未置顶的会话，按 (updated_at, conversation_id) 键集分页
+	ListUserConversations(ctx context.Context, arg ListUserConversationsParams) ([]ListUserConversationsRow, error)
+	// 全局收件箱同步：获取用户自 since 起有更新的会话（最近更新的优先），以及各会话在 since 之前的最大 seq
+	ListUserConversationsSince(ctx context.Context, arg ListUserConversationsSinceParams) ([]ListUserConversationsSinceRow, error)
 	// 获取用户列表
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	// 根据用户ID批量获取用户公开资料
+	ListUsersByIDs(ctx context.Context, ids []uint64) ([]ListUsersByIDsRow, error)
 	// 根据昵称获取用户信息（模糊匹配，支持分页）
 	ListUsersByNickname(ctx context.Context, arg ListUsersByNicknameParams) ([]ListUsersByNicknameRow, error)
 	MarkOutboxEventFailed(ctx context.Context, id uint64) error
 	MarkOutboxEventSent(ctx context.Context, id uint64) error
+	// 推进已读位置并清零未读；未读 @ 按剩余的 @ 明细重新统计，需先执行 DeleteReadMentions
 	MarkRead(ctx context.Context, arg MarkReadParams) error
 	// 拒绝好友申请
 	RejectFriendRequest(ctx context.Context, arg RejectFriendRequestParams) error
+	// 设置/清除全员禁言（mute_all_until 为 NULL 表示解除）
+	SetGroupMuteAll(ctx context.Context, arg SetGroupMuteAllParams) error
+	// 设置/清除成员禁言（muted_until 为 NULL 表示解除）
+	SetGroupUserMute(ctx context.Context, arg SetGroupUserMuteParams) error
+	// 按 group_user 实际成员数回写群组人数
+	SyncGroupUserNum(ctx context.Context, arg SyncGroupUserNumParams) error
 	// 取消屏蔽好友
 	UnblockFriend(ctx context.Context, arg UnblockFriendParams) error
 	// 设置设备离线
@@ -152,6 +193,7 @@ type Querier interface {
 	UpdateGroupUserNum(ctx context.Context, arg UpdateGroupUserNumParams) error
 	// 更新群组成员类型
 	UpdateGroupUserType(ctx context.Context, arg UpdateGroupUserTypeParams) error
+	UpdateMessageIndexStatus(ctx context.Context, arg UpdateMessageIndexStatusParams) error
 	// 更新消息状态（如撤回消息）
 	UpdateMessageStatus(ctx context.Context, arg UpdateMessageStatusParams) error
 	// 更新序列号
@@ -163,6 +205,10 @@ type Querier interface {
 	// 更新用户密码
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
 	UpsertConversationOnSend(ctx context.Context, arg UpsertConversationOnSendParams) error
+	// 群聊会话：参与者以 group_user 为准，participants 留空
+	UpsertGroupConversationOnSend(ctx context.Context, arg UpsertGroupConversationOnSendParams) error
+	// 为群内所有成员确保 user_conversation 存在
+	UpsertGroupUserConversationsOnSend(ctx context.Context, arg UpsertGroupUserConversationsOnSendParams) error
 	UpsertUserConversationOnSend(ctx context.Context, arg UpsertUserConversationOnSendParams) error
 	// 检查用户名是否存在
 	UserExistsByUsername(ctx context.Context, username string) (bool, error)
@@ -37,15 +37,35 @@ func (m *MockDeviceIntServiceClient) EXPECT() *MockDeviceIntServiceClientMockRec
 	return m.recorder
 }
 
+// BatchOffline mocks base method.
+func (m *MockDeviceIntServiceClient) BatchOffline(ctx context.Context, in *devicepb.BatchOfflineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchOffline", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchOffline indicates an expected call of BatchOffline.
+func (mr *MockDeviceIntServiceClientMockRecorder) BatchOffline(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchOffline", reflect.TypeOf((*MockDeviceIntServiceClient)(nil).BatchOffline), varargs...)
+}
+
 // ConnSignIn mocks base method.
-func (m *MockDeviceIntServiceClient) ConnSignIn(ctx context.Context, in *devicepb.ConnSignInRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (m *MockDeviceIntServiceClient) ConnSignIn(ctx context.Context, in *devicepb.ConnSignInRequest, opts ...grpc.CallOption) (*devicepb.ConnSignInReply, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ConnSignIn", varargs...)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret0, _ := ret[0].(*devicepb.ConnSignInReply)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -57,6 +77,46 @@ func (mr *MockDeviceIntServiceClientMockRecorder) ConnSignIn(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnSignIn", reflect.TypeOf((*MockDeviceIntServiceClient)(nil).ConnSignIn), varargs...)
 }
 
+// Heartbeat mocks base method.
+func (m *MockDeviceIntServiceClient) Heartbeat(ctx context.Context, in *devicepb.HeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Heartbeat", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Heartbeat indicates an expected call of Heartbeat.
+func (mr *MockDeviceIntServiceClientMockRecorder) Heartbeat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockDeviceIntServiceClient)(nil).Heartbeat), varargs...)
+}
+
+// ListOnlineDevices mocks base method.
+func (m *MockDeviceIntServiceClient) ListOnlineDevices(ctx context.Context, in *devicepb.ListOnlineDevicesRequest, opts ...grpc.CallOption) (*devicepb.ListOnlineDevicesReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOnlineDevices", varargs...)
+	ret0, _ := ret[0].(*devicepb.ListOnlineDevicesReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOnlineDevices indicates an expected call of ListOnlineDevices.
+func (mr *MockDeviceIntServiceClientMockRecorder) ListOnlineDevices(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOnlineDevices", reflect.TypeOf((*MockDeviceIntServiceClient)(nil).ListOnlineDevices), varargs...)
+}
+
 // Offline mocks base method.
 func (m *MockDeviceIntServiceClient) Offline(ctx context.Context, in *devicepb.OfflineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -100,11 +160,26 @@ func (m *MockDeviceIntServiceServer) EXPECT() *MockDeviceIntServiceServerMockRec
 	return m.recorder
 }
 
+// BatchOffline mocks base method.
+func (m *MockDeviceIntServiceServer) BatchOffline(arg0 context.Context, arg1 *devicepb.BatchOfflineRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchOffline", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchOffline indicates an expected call of BatchOffline.
+func (mr *MockDeviceIntServiceServerMockRecorder) BatchOffline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchOffline", reflect.TypeOf((*MockDeviceIntServiceServer)(nil).BatchOffline), arg0, arg1)
+}
+
 // ConnSignIn mocks base method.
-func (m *MockDeviceIntServiceServer) ConnSignIn(arg0 context.Context, arg1 *devicepb.ConnSignInRequest) (*emptypb.Empty, error) {
+func (m *MockDeviceIntServiceServer) ConnSignIn(arg0 context.Context, arg1 *devicepb.ConnSignInRequest) (*devicepb.ConnSignInReply, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ConnSignIn", arg0, arg1)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret0, _ := ret[0].(*devicepb.ConnSignInReply)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -115,6 +190,36 @@ func (mr *MockDeviceIntServiceServerMockRecorder) ConnSignIn(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnSignIn", reflect.TypeOf((*MockDeviceIntServiceServer)(nil).ConnSignIn), arg0, arg1)
 }
 
+// Heartbeat mocks base method.
+func (m *MockDeviceIntServiceServer) Heartbeat(arg0 context.Context, arg1 *devicepb.HeartbeatRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Heartbeat", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Heartbeat indicates an expected call of Heartbeat.
+func (mr *MockDeviceIntServiceServerMockRecorder) Heartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockDeviceIntServiceServer)(nil).Heartbeat), arg0, arg1)
+}
+
+// ListOnlineDevices mocks base method.
+func (m *MockDeviceIntServiceServer) ListOnlineDevices(arg0 context.Context, arg1 *devicepb.ListOnlineDevicesRequest) (*devicepb.ListOnlineDevicesReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOnlineDevices", arg0, arg1)
+	ret0, _ := ret[0].(*devicepb.ListOnlineDevicesReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOnlineDevices indicates an expected call of ListOnlineDevices.
+func (mr *MockDeviceIntServiceServerMockRecorder) ListOnlineDevices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOnlineDevices", reflect.TypeOf((*MockDeviceIntServiceServer)(nil).ListOnlineDevices), arg0, arg1)
+}
+
 // Offline mocks base method.
 func (m *MockDeviceIntServiceServer) Offline(arg0 context.Context, arg1 *devicepb.OfflineRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
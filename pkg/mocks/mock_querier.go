@@ -224,6 +224,20 @@ func (mr *MockQuerierMockRecorder) CreateUserMessage(ctx, arg interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserMessage", reflect.TypeOf((*MockQuerier)(nil).CreateUserMessage), ctx, arg)
 }
 
+// DeleteConversationUsers mocks base method.
+func (m *MockQuerier) DeleteConversationUsers(ctx context.Context, conversationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConversationUsers", ctx, conversationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConversationUsers indicates an expected call of DeleteConversationUsers.
+func (mr *MockQuerierMockRecorder) DeleteConversationUsers(ctx, conversationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConversationUsers", reflect.TypeOf((*MockQuerier)(nil).DeleteConversationUsers), ctx, conversationID)
+}
+
 // DeleteDevice mocks base method.
 func (m *MockQuerier) DeleteDevice(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -294,6 +308,20 @@ func (mr *MockQuerierMockRecorder) DeleteGroupUser(ctx, arg interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupUser", reflect.TypeOf((*MockQuerier)(nil).DeleteGroupUser), ctx, arg)
 }
 
+// DeleteGroupUsers mocks base method.
+func (m *MockQuerier) DeleteGroupUsers(ctx context.Context, groupID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupUsers", ctx, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupUsers indicates an expected call of DeleteGroupUsers.
+func (mr *MockQuerierMockRecorder) DeleteGroupUsers(ctx, groupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupUsers", reflect.TypeOf((*MockQuerier)(nil).DeleteGroupUsers), ctx, groupID)
+}
+
 // DeleteMessage mocks base method.
 func (m *MockQuerier) DeleteMessage(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -308,6 +336,20 @@ func (mr *MockQuerierMockRecorder) DeleteMessage(ctx, id interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockQuerier)(nil).DeleteMessage), ctx, id)
 }
 
+// DeleteReadMentions mocks base method.
+func (m *MockQuerier) DeleteReadMentions(ctx context.Context, arg dao.DeleteReadMentionsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReadMentions", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReadMentions indicates an expected call of DeleteReadMentions.
+func (mr *MockQuerierMockRecorder) DeleteReadMentions(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReadMentions", reflect.TypeOf((*MockQuerier)(nil).DeleteReadMentions), ctx, arg)
+}
+
 // DeleteSeq mocks base method.
 func (m *MockQuerier) DeleteSeq(ctx context.Context, arg dao.DeleteSeqParams) error {
 	m.ctrl.T.Helper()
@@ -336,6 +378,20 @@ func (mr *MockQuerierMockRecorder) DeleteUser(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockQuerier)(nil).DeleteUser), ctx, id)
 }
 
+// DeleteUserConversation mocks base method.
+func (m *MockQuerier) DeleteUserConversation(ctx context.Context, arg dao.DeleteUserConversationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserConversation", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserConversation indicates an expected call of DeleteUserConversation.
+func (mr *MockQuerierMockRecorder) DeleteUserConversation(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserConversation", reflect.TypeOf((*MockQuerier)(nil).DeleteUserConversation), ctx, arg)
+}
+
 // DeleteUserMessage mocks base method.
 func (m *MockQuerier) DeleteUserMessage(ctx context.Context, arg dao.DeleteUserMessageParams) error {
 	m.ctrl.T.Helper()
@@ -365,6 +421,21 @@ func (mr *MockQuerierMockRecorder) GetBlockedFriends(ctx, userID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedFriends", reflect.TypeOf((*MockQuerier)(nil).GetBlockedFriends), ctx, userID)
 }
 
+// GetConversation mocks base method.
+func (m *MockQuerier) GetConversation(ctx context.Context, conversationID string) (dao.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversation", ctx, conversationID)
+	ret0, _ := ret[0].(dao.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversation indicates an expected call of GetConversation.
+func (mr *MockQuerierMockRecorder) GetConversation(ctx, conversationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversation", reflect.TypeOf((*MockQuerier)(nil).GetConversation), ctx, conversationID)
+}
+
 // GetConversationMessages mocks base method.
 func (m *MockQuerier) GetConversationMessages(ctx context.Context, arg dao.GetConversationMessagesParams) ([]dao.MessageIndex, error) {
 	m.ctrl.T.Helper()
@@ -380,6 +451,21 @@ func (mr *MockQuerierMockRecorder) GetConversationMessages(ctx, arg interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationMessages", reflect.TypeOf((*MockQuerier)(nil).GetConversationMessages), ctx, arg)
 }
 
+// GetConversationMessagesAfter mocks base method.
+func (m *MockQuerier) GetConversationMessagesAfter(ctx context.Context, arg dao.GetConversationMessagesAfterParams) ([]dao.MessageIndex, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationMessagesAfter", ctx, arg)
+	ret0, _ := ret[0].([]dao.MessageIndex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationMessagesAfter indicates an expected call of GetConversationMessagesAfter.
+func (mr *MockQuerierMockRecorder) GetConversationMessagesAfter(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationMessagesAfter", reflect.TypeOf((*MockQuerier)(nil).GetConversationMessagesAfter), ctx, arg)
+}
+
 // GetDevice mocks base method.
 func (m *MockQuerier) GetDevice(ctx context.Context, id uint64) (dao.Device, error) {
 	m.ctrl.T.Helper()
@@ -500,6 +586,21 @@ func (mr *MockQuerierMockRecorder) GetGroupUsers(ctx, groupID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsers", reflect.TypeOf((*MockQuerier)(nil).GetGroupUsers), ctx, groupID)
 }
 
+// GetLastReadSeq mocks base method.
+func (m *MockQuerier) GetLastReadSeq(ctx context.Context, arg dao.GetLastReadSeqParams) (sql.NullInt64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastReadSeq", ctx, arg)
+	ret0, _ := ret[0].(sql.NullInt64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastReadSeq indicates an expected call of GetLastReadSeq.
+func (mr *MockQuerierMockRecorder) GetLastReadSeq(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastReadSeq", reflect.TypeOf((*MockQuerier)(nil).GetLastReadSeq), ctx, arg)
+}
+
 // GetMessage mocks base method.
 func (m *MockQuerier) GetMessage(ctx context.Context, id uint64) (dao.Message, error) {
 	m.ctrl.T.Helper()
@@ -515,6 +616,21 @@ func (mr *MockQuerierMockRecorder) GetMessage(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessage", reflect.TypeOf((*MockQuerier)(nil).GetMessage), ctx, id)
 }
 
+// GetMessageIndex mocks base method.
+func (m *MockQuerier) GetMessageIndex(ctx context.Context, messageID string) (dao.MessageIndex, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageIndex", ctx, messageID)
+	ret0, _ := ret[0].(dao.MessageIndex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageIndex indicates an expected call of GetMessageIndex.
+func (mr *MockQuerierMockRecorder) GetMessageIndex(ctx, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageIndex", reflect.TypeOf((*MockQuerier)(nil).GetMessageIndex), ctx, messageID)
+}
+
 // GetOnlineDevices mocks base method.
 func (m *MockQuerier) GetOnlineDevices(ctx context.Context) ([]dao.Device, error) {
 	m.ctrl.T.Helper()
@@ -858,6 +974,34 @@ func (mr *MockQuerierMockRecorder) IgnoreFriendRequest(ctx, arg interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IgnoreFriendRequest", reflect.TypeOf((*MockQuerier)(nil).IgnoreFriendRequest), ctx, arg)
 }
 
+// IncrGroupUnreadExceptSender mocks base method.
+func (m *MockQuerier) IncrGroupUnreadExceptSender(ctx context.Context, arg dao.IncrGroupUnreadExceptSenderParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrGroupUnreadExceptSender", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrGroupUnreadExceptSender indicates an expected call of IncrGroupUnreadExceptSender.
+func (mr *MockQuerierMockRecorder) IncrGroupUnreadExceptSender(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrGroupUnreadExceptSender", reflect.TypeOf((*MockQuerier)(nil).IncrGroupUnreadExceptSender), ctx, arg)
+}
+
+// IncrMentionOnUsers mocks base method.
+func (m *MockQuerier) IncrMentionOnUsers(ctx context.Context, arg dao.IncrMentionOnUsersParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrMentionOnUsers", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrMentionOnUsers indicates an expected call of IncrMentionOnUsers.
+func (mr *MockQuerierMockRecorder) IncrMentionOnUsers(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrMentionOnUsers", reflect.TypeOf((*MockQuerier)(nil).IncrMentionOnUsers), ctx, arg)
+}
+
 // IncrUnreadOnRecipient mocks base method.
 func (m *MockQuerier) IncrUnreadOnRecipient(ctx context.Context, arg dao.IncrUnreadOnRecipientParams) error {
 	m.ctrl.T.Helper()
@@ -914,6 +1058,20 @@ func (mr *MockQuerierMockRecorder) InsertOutboxEvent(ctx, arg interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOutboxEvent", reflect.TypeOf((*MockQuerier)(nil).InsertOutboxEvent), ctx, arg)
 }
 
+// InsertUserMentions mocks base method.
+func (m *MockQuerier) InsertUserMentions(ctx context.Context, arg dao.InsertUserMentionsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserMentions", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertUserMentions indicates an expected call of InsertUserMentions.
+func (mr *MockQuerierMockRecorder) InsertUserMentions(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserMentions", reflect.TypeOf((*MockQuerier)(nil).InsertUserMentions), ctx, arg)
+}
+
 // ListGroups mocks base method.
 func (m *MockQuerier) ListGroups(ctx context.Context, arg dao.ListGroupsParams) ([]dao.Group, error) {
 	m.ctrl.T.Helper()
@@ -929,6 +1087,96 @@ func (mr *MockQuerierMockRecorder) ListGroups(ctx, arg interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockQuerier)(nil).ListGroups), ctx, arg)
 }
 
+// ListGroupsByIDs mocks base method.
+func (m *MockQuerier) ListGroupsByIDs(ctx context.Context, ids []uint64) ([]dao.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]dao.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupsByIDs indicates an expected call of ListGroupsByIDs.
+func (mr *MockQuerierMockRecorder) ListGroupsByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupsByIDs", reflect.TypeOf((*MockQuerier)(nil).ListGroupsByIDs), ctx, ids)
+}
+
+// ListMessageIndexByIDs mocks base method.
+func (m *MockQuerier) ListMessageIndexByIDs(ctx context.Context, messageIds []string) ([]dao.MessageIndex, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMessageIndexByIDs", ctx, messageIds)
+	ret0, _ := ret[0].([]dao.MessageIndex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMessageIndexByIDs indicates an expected call of ListMessageIndexByIDs.
+func (mr *MockQuerierMockRecorder) ListMessageIndexByIDs(ctx, messageIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessageIndexByIDs", reflect.TypeOf((*MockQuerier)(nil).ListMessageIndexByIDs), ctx, messageIds)
+}
+
+// ListMessageSenders mocks base method.
+func (m *MockQuerier) ListMessageSenders(ctx context.Context, arg dao.ListMessageSendersParams) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMessageSenders", ctx, arg)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMessageSenders indicates an expected call of ListMessageSenders.
+func (mr *MockQuerierMockRecorder) ListMessageSenders(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessageSenders", reflect.TypeOf((*MockQuerier)(nil).ListMessageSenders), ctx, arg)
+}
+
+// ListPinnedUserConversations mocks base method.
+func (m *MockQuerier) ListPinnedUserConversations(ctx context.Context, arg dao.ListPinnedUserConversationsParams) ([]dao.ListPinnedUserConversationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPinnedUserConversations", ctx, arg)
+	ret0, _ := ret[0].([]dao.ListPinnedUserConversationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPinnedUserConversations indicates an expected call of ListPinnedUserConversations.
+func (mr *MockQuerierMockRecorder) ListPinnedUserConversations(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPinnedUserConversations", reflect.TypeOf((*MockQuerier)(nil).ListPinnedUserConversations), ctx, arg)
+}
+
+// ListUserConversations mocks base method.
+func (m *MockQuerier) ListUserConversations(ctx context.Context, arg dao.ListUserConversationsParams) ([]dao.ListUserConversationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserConversations", ctx, arg)
+	ret0, _ := ret[0].([]dao.ListUserConversationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserConversations indicates an expected call of ListUserConversations.
+func (mr *MockQuerierMockRecorder) ListUserConversations(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserConversations", reflect.TypeOf((*MockQuerier)(nil).ListUserConversations), ctx, arg)
+}
+
+// ListUserConversationsSince mocks base method.
+func (m *MockQuerier) ListUserConversationsSince(ctx context.Context, arg dao.ListUserConversationsSinceParams) ([]dao.ListUserConversationsSinceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserConversationsSince", ctx, arg)
+	ret0, _ := ret[0].([]dao.ListUserConversationsSinceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserConversationsSince indicates an expected call of ListUserConversationsSince.
+func (mr *MockQuerierMockRecorder) ListUserConversationsSince(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserConversationsSince", reflect.TypeOf((*MockQuerier)(nil).ListUserConversationsSince), ctx, arg)
+}
+
 // ListUsers mocks base method.
 func (m *MockQuerier) ListUsers(ctx context.Context, arg dao.ListUsersParams) ([]dao.User, error) {
 	m.ctrl.T.Helper()
@@ -944,6 +1192,21 @@ func (mr *MockQuerierMockRecorder) ListUsers(ctx, arg interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockQuerier)(nil).ListUsers), ctx, arg)
 }
 
+// ListUsersByIDs mocks base method.
+func (m *MockQuerier) ListUsersByIDs(ctx context.Context, ids []uint64) ([]dao.ListUsersByIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersByIDs", ctx, ids)
+	ret0, _ := ret[0].([]dao.ListUsersByIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersByIDs indicates an expected call of ListUsersByIDs.
+func (mr *MockQuerierMockRecorder) ListUsersByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersByIDs", reflect.TypeOf((*MockQuerier)(nil).ListUsersByIDs), ctx, ids)
+}
+
 // ListUsersByNickname mocks base method.
 func (m *MockQuerier) ListUsersByNickname(ctx context.Context, arg dao.ListUsersByNicknameParams) ([]dao.ListUsersByNicknameRow, error) {
 	m.ctrl.T.Helper()
@@ -1015,6 +1278,48 @@ func (mr *MockQuerierMockRecorder) RejectFriendRequest(ctx, arg interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectFriendRequest", reflect.TypeOf((*MockQuerier)(nil).RejectFriendRequest), ctx, arg)
 }
 
+// SetGroupMuteAll mocks base method.
+func (m *MockQuerier) SetGroupMuteAll(ctx context.Context, arg dao.SetGroupMuteAllParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGroupMuteAll", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGroupMuteAll indicates an expected call of SetGroupMuteAll.
+func (mr *MockQuerierMockRecorder) SetGroupMuteAll(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGroupMuteAll", reflect.TypeOf((*MockQuerier)(nil).SetGroupMuteAll), ctx, arg)
+}
+
+// SetGroupUserMute mocks base method.
+func (m *MockQuerier) SetGroupUserMute(ctx context.Context, arg dao.SetGroupUserMuteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGroupUserMute", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGroupUserMute indicates an expected call of SetGroupUserMute.
+func (mr *MockQuerierMockRecorder) SetGroupUserMute(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGroupUserMute", reflect.TypeOf((*MockQuerier)(nil).SetGroupUserMute), ctx, arg)
+}
+
+// SyncGroupUserNum mocks base method.
+func (m *MockQuerier) SyncGroupUserNum(ctx context.Context, arg dao.SyncGroupUserNumParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncGroupUserNum", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncGroupUserNum indicates an expected call of SyncGroupUserNum.
+func (mr *MockQuerierMockRecorder) SyncGroupUserNum(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncGroupUserNum", reflect.TypeOf((*MockQuerier)(nil).SyncGroupUserNum), ctx, arg)
+}
+
 // UnblockFriend mocks base method.
 func (m *MockQuerier) UnblockFriend(ctx context.Context, arg dao.UnblockFriendParams) error {
 	m.ctrl.T.Helper()
@@ -1141,6 +1446,20 @@ func (mr *MockQuerierMockRecorder) UpdateGroupUserType(ctx, arg interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupUserType", reflect.TypeOf((*MockQuerier)(nil).UpdateGroupUserType), ctx, arg)
 }
 
+// UpdateMessageIndexStatus mocks base method.
+func (m *MockQuerier) UpdateMessageIndexStatus(ctx context.Context, arg dao.UpdateMessageIndexStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessageIndexStatus", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMessageIndexStatus indicates an expected call of UpdateMessageIndexStatus.
+func (mr *MockQuerierMockRecorder) UpdateMessageIndexStatus(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessageIndexStatus", reflect.TypeOf((*MockQuerier)(nil).UpdateMessageIndexStatus), ctx, arg)
+}
+
 // UpdateMessageStatus mocks base method.
 func (m *MockQuerier) UpdateMessageStatus(ctx context.Context, arg dao.UpdateMessageStatusParams) error {
 	m.ctrl.T.Helper()
@@ -1225,6 +1544,34 @@ func (mr *MockQuerierMockRecorder) UpsertConversationOnSend(ctx, arg interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertConversationOnSend", reflect.TypeOf((*MockQuerier)(nil).UpsertConversationOnSend), ctx, arg)
 }
 
+// UpsertGroupConversationOnSend mocks base method.
+func (m *MockQuerier) UpsertGroupConversationOnSend(ctx context.Context, arg dao.UpsertGroupConversationOnSendParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertGroupConversationOnSend", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertGroupConversationOnSend indicates an expected call of UpsertGroupConversationOnSend.
+func (mr *MockQuerierMockRecorder) UpsertGroupConversationOnSend(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertGroupConversationOnSend", reflect.TypeOf((*MockQuerier)(nil).UpsertGroupConversationOnSend), ctx, arg)
+}
+
+// UpsertGroupUserConversationsOnSend mocks base method.
+func (m *MockQuerier) UpsertGroupUserConversationsOnSend(ctx context.Context, arg dao.UpsertGroupUserConversationsOnSendParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertGroupUserConversationsOnSend", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertGroupUserConversationsOnSend indicates an expected call of UpsertGroupUserConversationsOnSend.
+func (mr *MockQuerierMockRecorder) UpsertGroupUserConversationsOnSend(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertGroupUserConversationsOnSend", reflect.TypeOf((*MockQuerier)(nil).UpsertGroupUserConversationsOnSend), ctx, arg)
+}
+
 // UpsertUserConversationOnSend mocks base method.
 func (m *MockQuerier) UpsertUserConversationOnSend(ctx context.Context, arg dao.UpsertUserConversationOnSendParams) error {
 	m.ctrl.T.Helper()
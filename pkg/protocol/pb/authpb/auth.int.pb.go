@@ -511,7 +511,7 @@ const file_pkg_protocol_proto_auth_auth_int_proto_rawDesc = "" +
 	"\x0eAuthIntService\x12[\n" +
 	"\bRegister\x12\x15.auth.RegisterRequest\x1a\x16.auth.RegisterResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/auth/register\x12O\n" +
 	"\x05Login\x12\x12.auth.LoginRequest\x1a\x13.auth.LoginResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/auth/login\x12M\n" +
-	"\x04Auth\x12\x11.auth.AuthRequest\x1a\x12.auth.AuthResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/auth/verifyB\x18Z\x16pkg/protocol/pb/authpbb\x06proto3"
+	"\x04Auth\x12\x11.auth.AuthRequest\x1a\x12.auth.AuthResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/auth/verifyB\"Z im-server/pkg/protocol/pb/authpbb\x06proto3"
 
 var (
 	file_pkg_protocol_proto_auth_auth_int_proto_rawDescOnce sync.Once
@@ -7,8 +7,10 @@
 package connectpb
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	messagepb "im-server/pkg/protocol/pb/messagepb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,23 +26,37 @@ const (
 type Command int32
 
 const (
-	Command_UNKNOWN        Command = 0 // 未知
-	Command_SIGN_IN        Command = 1 // 设备登录请求
-	Command_SYNC           Command = 2 // 消息同步触发
-	Command_HEARTBEAT      Command = 3 // 心跳
-	Command_MESSAGE        Command = 4 // 消息投递
-	Command_SUBSCRIBE_ROOM Command = 5 // 订阅房间
+	Command_UNKNOWN        Command = 0  // 未知
+	Command_SIGN_IN        Command = 1  // 设备登录请求
+	Command_SYNC           Command = 2  // 消息同步触发
+	Command_HEARTBEAT      Command = 3  // 心跳
+	Command_MESSAGE        Command = 4  // 消息投递；上行为发送消息，data 为 message.SendMessageRequest，以相同 request_id 回复 message.SendMessageReply
+	Command_SUBSCRIBE_ROOM Command = 5  // 订阅房间
+	Command_READ_ACK       Command = 6  // 会话已读上报
+	Command_READ_RECEIPT   Command = 7  // 已读回执推送
+	Command_RECALL         Command = 8  // 消息撤回推送
+	Command_DELIVER_ACK    Command = 9  // 消息送达确认
+	Command_KICKED         Command = 10 // 被踢下线通知
+	Command_RECONNECT      Command = 11 // 节点下线，通知客户端重连
+	Command_SIGNAL         Command = 12 // 瞬时信号（如正在输入），只转发不存储
 )
 
 // Enum value maps for Command.
 var (
 	Command_name = map[int32]string{
-		0: "UNKNOWN",
-		1: "SIGN_IN",
-		2: "SYNC",
-		3: "HEARTBEAT",
-		4: "MESSAGE",
-		5: "SUBSCRIBE_ROOM",
+		0:  "UNKNOWN",
+		1:  "SIGN_IN",
+		2:  "SYNC",
+		3:  "HEARTBEAT",
+		4:  "MESSAGE",
+		5:  "SUBSCRIBE_ROOM",
+		6:  "READ_ACK",
+		7:  "READ_RECEIPT",
+		8:  "RECALL",
+		9:  "DELIVER_ACK",
+		10: "KICKED",
+		11: "RECONNECT",
+		12: "SIGNAL",
 	}
 	Command_value = map[string]int32{
 		"UNKNOWN":        0,
@@ -49,6 +65,13 @@ var (
 		"HEARTBEAT":      3,
 		"MESSAGE":        4,
 		"SUBSCRIBE_ROOM": 5,
+		"READ_ACK":       6,
+		"READ_RECEIPT":   7,
+		"RECALL":         8,
+		"DELIVER_ACK":    9,
+		"KICKED":         10,
+		"RECONNECT":      11,
+		"SIGNAL":         12,
 	}
 )
 
@@ -79,12 +102,98 @@ func (Command) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{0}
 }
 
+// 错误码，作为 Packet.code 返回，0 表示成功
+type ErrorCode int32
+
+const (
+	ErrorCode_OK                      ErrorCode = 0   // 成功
+	ErrorCode_ERR_UNKNOWN             ErrorCode = 1   // 未知错误
+	ErrorCode_ERR_INVALID_ARGUMENT    ErrorCode = 2   // 请求参数错误
+	ErrorCode_ERR_UNAUTHENTICATED     ErrorCode = 3   // 身份认证失败，如 token 无效或过期
+	ErrorCode_ERR_PERMISSION_DENIED   ErrorCode = 4   // 无权限
+	ErrorCode_ERR_NOT_FOUND           ErrorCode = 5   // 资源不存在；心跳返回时表示在线状态已过期，需重新登录
+	ErrorCode_ERR_ALREADY_EXISTS      ErrorCode = 6   // 资源已存在
+	ErrorCode_ERR_RESOURCE_EXHAUSTED  ErrorCode = 7   // 请求过于频繁或超出配额
+	ErrorCode_ERR_FAILED_PRECONDITION ErrorCode = 8   // 当前状态不允许该操作，如超出撤回时限
+	ErrorCode_ERR_UNAVAILABLE         ErrorCode = 9   // 服务暂不可用，可稍后重试
+	ErrorCode_ERR_DEADLINE_EXCEEDED   ErrorCode = 10  // 请求超时，可稍后重试
+	ErrorCode_ERR_INTERNAL            ErrorCode = 11  // 服务内部错误
+	ErrorCode_ERR_BAD_PACKET          ErrorCode = 100 // 数据包无法解析
+	ErrorCode_ERR_NOT_SIGNED_IN       ErrorCode = 101 // 连接尚未登录，需先发送 SIGN_IN
+	ErrorCode_ERR_UNKNOWN_COMMAND     ErrorCode = 102 // 不支持的指令
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:   "OK",
+		1:   "ERR_UNKNOWN",
+		2:   "ERR_INVALID_ARGUMENT",
+		3:   "ERR_UNAUTHENTICATED",
+		4:   "ERR_PERMISSION_DENIED",
+		5:   "ERR_NOT_FOUND",
+		6:   "ERR_ALREADY_EXISTS",
+		7:   "ERR_RESOURCE_EXHAUSTED",
+		8:   "ERR_FAILED_PRECONDITION",
+		9:   "ERR_UNAVAILABLE",
+		10:  "ERR_DEADLINE_EXCEEDED",
+		11:  "ERR_INTERNAL",
+		100: "ERR_BAD_PACKET",
+		101: "ERR_NOT_SIGNED_IN",
+		102: "ERR_UNKNOWN_COMMAND",
+	}
+	ErrorCode_value = map[string]int32{
+		"OK":                      0,
+		"ERR_UNKNOWN":             1,
+		"ERR_INVALID_ARGUMENT":    2,
+		"ERR_UNAUTHENTICATED":     3,
+		"ERR_PERMISSION_DENIED":   4,
+		"ERR_NOT_FOUND":           5,
+		"ERR_ALREADY_EXISTS":      6,
+		"ERR_RESOURCE_EXHAUSTED":  7,
+		"ERR_FAILED_PRECONDITION": 8,
+		"ERR_UNAVAILABLE":         9,
+		"ERR_DEADLINE_EXCEEDED":   10,
+		"ERR_INTERNAL":            11,
+		"ERR_BAD_PACKET":          100,
+		"ERR_NOT_SIGNED_IN":       101,
+		"ERR_UNKNOWN_COMMAND":     102,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_enumTypes[1].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_pkg_protocol_proto_connect_connect_ext_proto_enumTypes[1]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{1}
+}
+
 // 包
 type Packet struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Command       Command                `protobuf:"varint,1,opt,name=command,proto3,enum=connect.Command" json:"command,omitempty"` // 指令 说明了用于通信的数据包对应的服务类型
 	RequestId     int64                  `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // 请求id 用于解决异步请求时server端和客户端的请求响应匹配问题
-	Code          uint32                 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`                            // 错误码
+	Code          uint32                 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`                            // 错误码，取值见 ErrorCode
 	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`                       // 错误信息
 	Data          []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`                             // 数据
 	unknownFields protoimpl.UnknownFields
@@ -217,29 +326,889 @@ func (x *SignInInput) GetToken() string {
 	return ""
 }
 
-var File_pkg_protocol_proto_connect_connect_ext_proto protoreflect.FileDescriptor
+// 消息同步触发,package_type:2
+// 每个 SYNC 请求回复一批 message.SyncMessagesReply，has_more 为 true 时客户端以回复中的 cursors 再次发起 SYNC
+// 服务端也会主动下发不带数据的 SYNC 包（如推送因写队列溢出被丢弃），提示客户端发起同步
+type SyncInput struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Cursors       []*messagepb.SyncCursor `protobuf:"bytes,1,rep,name=cursors,proto3" json:"cursors,omitempty"`                             // 各会话已知的最大 seq
+	InboxCursor   int64                   `protobuf:"varint,2,opt,name=inbox_cursor,json=inboxCursor,proto3" json:"inbox_cursor,omitempty"` // 全局收件箱游标，cursors 为空时使用，见 message.SyncMessagesRequest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_pkg_protocol_proto_connect_connect_ext_proto_rawDesc = "" +
-	"\n" +
-	",pkg/protocol/proto/connect/connect.ext.proto\x12\aconnect\"\x95\x01\n" +
-	"\x06Packet\x12*\n" +
-	"\acommand\x18\x01 \x01(\x0e2\x10.connect.CommandR\acommand\x12\x1d\n" +
-	"\n" +
-	"request_id\x18\x02 \x01(\x03R\trequestId\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\rR\x04code\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\x12\x12\n" +
-	"\x04data\x18\x05 \x01(\fR\x04data\"Y\n" +
-	"\vSignInInput\x12\x1b\n" +
-	"\tdevice_id\x18\x01 \x01(\x04R\bdeviceId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
-	"\x05token\x18\x03 \x01(\tR\x05token*]\n" +
-	"\aCommand\x12\v\n" +
-	"\aUNKNOWN\x10\x00\x12\v\n" +
-	"\aSIGN_IN\x10\x01\x12\b\n" +
-	"\x04SYNC\x10\x02\x12\r\n" +
-	"\tHEARTBEAT\x10\x03\x12\v\n" +
-	"\aMESSAGE\x10\x04\x12\x12\n" +
-	"\x0eSUBSCRIBE_ROOM\x10\x05B\x1bZ\x19pkg/protocol/pb/connectpbb\x06proto3"
+func (x *SyncInput) Reset() {
+	*x = SyncInput{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncInput) ProtoMessage() {}
+
+func (x *SyncInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncInput.ProtoReflect.Descriptor instead.
+func (*SyncInput) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SyncInput) GetCursors() []*messagepb.SyncCursor {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+func (x *SyncInput) GetInboxCursor() int64 {
+	if x != nil {
+		return x.InboxCursor
+	}
+	return 0
+}
+
+// 心跳响应,package_type:3
+type HeartbeatOutput struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ServerTime        int64                  `protobuf:"varint,1,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`                      // 服务端时间（毫秒时间戳）
+	HeartbeatInterval uint32                 `protobuf:"varint,2,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"` // 建议的心跳间隔（秒）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HeartbeatOutput) Reset() {
+	*x = HeartbeatOutput{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatOutput) ProtoMessage() {}
+
+func (x *HeartbeatOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatOutput.ProtoReflect.Descriptor instead.
+func (*HeartbeatOutput) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HeartbeatOutput) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+func (x *HeartbeatOutput) GetHeartbeatInterval() uint32 {
+	if x != nil {
+		return x.HeartbeatInterval
+	}
+	return 0
+}
+
+// 消息投递,package_type:4
+type MessageEvent struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	MessageId        string                    `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                       // 消息id
+	ConversationId   string                    `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`        // 会话id
+	ConversationType int32                     `protobuf:"varint,3,opt,name=conversation_type,json=conversationType,proto3" json:"conversation_type,omitempty"` // 会话类型 1:单聊 2:群聊
+	GroupId          uint64                    `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                            // 群聊群组id
+	Seq              int64                     `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`                                                   // 会话内序列号
+	Sender           *messagepb.PeerInfo       `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`                                              // 发送者资料
+	RecipientId      uint64                    `protobuf:"varint,7,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`                // 单聊接收方id
+	MessageType      int32                     `protobuf:"varint,8,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`                // 消息类型
+	Content          *messagepb.MessageContent `protobuf:"bytes,9,opt,name=content,proto3" json:"content,omitempty"`                                            // 消息内容（含 @ 信息）
+	ReplyTo          *messagepb.QuotedMessage  `protobuf:"bytes,10,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`                            // 被引用消息的摘要
+	SendTime         int64                     `protobuf:"varint,11,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`                        // 发送时间（毫秒时间戳）
+	ClientMsgId      string                    `protobuf:"bytes,12,opt,name=client_msg_id,json=clientMsgId,proto3" json:"client_msg_id,omitempty"`              // 发送方客户端消息id
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MessageEvent) Reset() {
+	*x = MessageEvent{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageEvent) ProtoMessage() {}
+
+func (x *MessageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageEvent.ProtoReflect.Descriptor instead.
+func (*MessageEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MessageEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageEvent) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *MessageEvent) GetConversationType() int32 {
+	if x != nil {
+		return x.ConversationType
+	}
+	return 0
+}
+
+func (x *MessageEvent) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *MessageEvent) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MessageEvent) GetSender() *messagepb.PeerInfo {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *MessageEvent) GetRecipientId() uint64 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *MessageEvent) GetMessageType() int32 {
+	if x != nil {
+		return x.MessageType
+	}
+	return 0
+}
+
+func (x *MessageEvent) GetContent() *messagepb.MessageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *MessageEvent) GetReplyTo() *messagepb.QuotedMessage {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return nil
+}
+
+func (x *MessageEvent) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+func (x *MessageEvent) GetClientMsgId() string {
+	if x != nil {
+		return x.ClientMsgId
+	}
+	return ""
+}
+
+// 订阅房间,package_type:5
+type SubscribeRoomInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"` // 房间id，0 表示退订当前房间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRoomInput) Reset() {
+	*x = SubscribeRoomInput{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRoomInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRoomInput) ProtoMessage() {}
+
+func (x *SubscribeRoomInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRoomInput.ProtoReflect.Descriptor instead.
+func (*SubscribeRoomInput) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SubscribeRoomInput) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+// 会话已读上报,package_type:6
+type ReadAckInput struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 会话id
+	ReadSeq        int64                  `protobuf:"varint,2,opt,name=read_seq,json=readSeq,proto3" json:"read_seq,omitempty"`                     // 已读到的序列号，0 表示读到最新
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReadAckInput) Reset() {
+	*x = ReadAckInput{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadAckInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAckInput) ProtoMessage() {}
+
+func (x *ReadAckInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAckInput.ProtoReflect.Descriptor instead.
+func (*ReadAckInput) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReadAckInput) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ReadAckInput) GetReadSeq() int64 {
+	if x != nil {
+		return x.ReadSeq
+	}
+	return 0
+}
+
+// 已读回执推送,package_type:7
+type ReadReceipt struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 会话id
+	ReaderId       uint64                 `protobuf:"varint,2,opt,name=reader_id,json=readerId,proto3" json:"reader_id,omitempty"`                  // 已读用户id
+	ReadSeq        int64                  `protobuf:"varint,3,opt,name=read_seq,json=readSeq,proto3" json:"read_seq,omitempty"`                     // 已读到的序列号
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReadReceipt) Reset() {
+	*x = ReadReceipt{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceipt) ProtoMessage() {}
+
+func (x *ReadReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceipt.ProtoReflect.Descriptor instead.
+func (*ReadReceipt) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReadReceipt) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ReadReceipt) GetReaderId() uint64 {
+	if x != nil {
+		return x.ReaderId
+	}
+	return 0
+}
+
+func (x *ReadReceipt) GetReadSeq() int64 {
+	if x != nil {
+		return x.ReadSeq
+	}
+	return 0
+}
+
+// 消息撤回推送,package_type:8
+type RecallNotice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 会话id
+	MessageId      string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                // 被撤回的消息id
+	Seq            int64                  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`                                            // 被撤回消息的序列号
+	OperatorId     uint64                 `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`            // 撤回操作人id
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecallNotice) Reset() {
+	*x = RecallNotice{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecallNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecallNotice) ProtoMessage() {}
+
+func (x *RecallNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecallNotice.ProtoReflect.Descriptor instead.
+func (*RecallNotice) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RecallNotice) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *RecallNotice) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RecallNotice) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RecallNotice) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+// 消息送达确认,package_type:9
+type DeliverAckInput struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 会话id
+	Seq            int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`                                            // 已收到消息的序列号
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeliverAckInput) Reset() {
+	*x = DeliverAckInput{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliverAckInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverAckInput) ProtoMessage() {}
+
+func (x *DeliverAckInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverAckInput.ProtoReflect.Descriptor instead.
+func (*DeliverAckInput) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeliverAckInput) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *DeliverAckInput) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// 被踢下线通知,package_type:10
+type KickedNotice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"` // 下线原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickedNotice) Reset() {
+	*x = KickedNotice{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickedNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickedNotice) ProtoMessage() {}
+
+func (x *KickedNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickedNotice.ProtoReflect.Descriptor instead.
+func (*KickedNotice) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *KickedNotice) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 重连通知,package_type:11
+type ReconnectNotice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RetryAfterMs  uint32                 `protobuf:"varint,1,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"` // 建议的重连延迟（毫秒），用于打散客户端的重连
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                    // 重连原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconnectNotice) Reset() {
+	*x = ReconnectNotice{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconnectNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconnectNotice) ProtoMessage() {}
+
+func (x *ReconnectNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconnectNotice.ProtoReflect.Descriptor instead.
+func (*ReconnectNotice) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReconnectNotice) GetRetryAfterMs() uint32 {
+	if x != nil {
+		return x.RetryAfterMs
+	}
+	return 0
+}
+
+func (x *ReconnectNotice) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 瞬时信号上行,package_type:12
+type SignalInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipientId   uint64                 `protobuf:"varint,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"` // 单聊接收方，须为好友
+	GroupId       uint64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`             // 群聊群组id，须为群成员
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                   // 信号类型，如 typing
+	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`                             // 自定义数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignalInput) Reset() {
+	*x = SignalInput{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalInput) ProtoMessage() {}
+
+func (x *SignalInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalInput.ProtoReflect.Descriptor instead.
+func (*SignalInput) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SignalInput) GetRecipientId() uint64 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *SignalInput) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SignalInput) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SignalInput) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 瞬时信号推送,package_type:12
+type SignalEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`          // 发送者id
+	RecipientId   uint64                 `protobuf:"varint,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"` // 单聊接收方id
+	GroupId       uint64                 `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`             // 群聊群组id
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                   // 信号类型
+	Payload       []byte                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`                             // 自定义数据
+	SendTime      int64                  `protobuf:"varint,6,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`          // 发送时间（毫秒时间戳）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignalEvent) Reset() {
+	*x = SignalEvent{}
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalEvent) ProtoMessage() {}
+
+func (x *SignalEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalEvent.ProtoReflect.Descriptor instead.
+func (*SignalEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SignalEvent) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *SignalEvent) GetRecipientId() uint64 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *SignalEvent) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SignalEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SignalEvent) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SignalEvent) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+var File_pkg_protocol_proto_connect_connect_ext_proto protoreflect.FileDescriptor
+
+const file_pkg_protocol_proto_connect_connect_ext_proto_rawDesc = "" +
+	"\n" +
+	",pkg/protocol/proto/connect/connect.ext.proto\x12\aconnect\x1a,pkg/protocol/proto/message/message.ext.proto\x1a\x17validate/validate.proto\"\x95\x01\n" +
+	"\x06Packet\x12*\n" +
+	"\acommand\x18\x01 \x01(\x0e2\x10.connect.CommandR\acommand\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\x03R\trequestId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\"Y\n" +
+	"\vSignInInput\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\x04R\bdeviceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"]\n" +
+	"\tSyncInput\x12-\n" +
+	"\acursors\x18\x01 \x03(\v2\x13.message.SyncCursorR\acursors\x12!\n" +
+	"\finbox_cursor\x18\x02 \x01(\x03R\vinboxCursor\"a\n" +
+	"\x0fHeartbeatOutput\x12\x1f\n" +
+	"\vserver_time\x18\x01 \x01(\x03R\n" +
+	"serverTime\x12-\n" +
+	"\x12heartbeat_interval\x18\x02 \x01(\rR\x11heartbeatInterval\"\xc8\x03\n" +
+	"\fMessageEvent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12+\n" +
+	"\x11conversation_type\x18\x03 \x01(\x05R\x10conversationType\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\x04R\agroupId\x12\x10\n" +
+	"\x03seq\x18\x05 \x01(\x03R\x03seq\x12)\n" +
+	"\x06sender\x18\x06 \x01(\v2\x11.message.PeerInfoR\x06sender\x12!\n" +
+	"\frecipient_id\x18\a \x01(\x04R\vrecipientId\x12!\n" +
+	"\fmessage_type\x18\b \x01(\x05R\vmessageType\x121\n" +
+	"\acontent\x18\t \x01(\v2\x17.message.MessageContentR\acontent\x121\n" +
+	"\breply_to\x18\n" +
+	" \x01(\v2\x16.message.QuotedMessageR\areplyTo\x12\x1b\n" +
+	"\tsend_time\x18\v \x01(\x03R\bsendTime\x12\"\n" +
+	"\rclient_msg_id\x18\f \x01(\tR\vclientMsgId\"-\n" +
+	"\x12SubscribeRoomInput\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x04R\x06roomId\"R\n" +
+	"\fReadAckInput\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x19\n" +
+	"\bread_seq\x18\x02 \x01(\x03R\areadSeq\"n\n" +
+	"\vReadReceipt\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\treader_id\x18\x02 \x01(\x04R\breaderId\x12\x19\n" +
+	"\bread_seq\x18\x03 \x01(\x03R\areadSeq\"\x89\x01\n" +
+	"\fRecallNotice\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x10\n" +
+	"\x03seq\x18\x03 \x01(\x03R\x03seq\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\x04R\n" +
+	"operatorId\"L\n" +
+	"\x0fDeliverAckInput\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\"&\n" +
+	"\fKickedNotice\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"O\n" +
+	"\x0fReconnectNotice\x12$\n" +
+	"\x0eretry_after_ms\x18\x01 \x01(\rR\fretryAfterMs\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x8e\x01\n" +
+	"\vSignalInput\x12!\n" +
+	"\frecipient_id\x18\x01 \x01(\x04R\vrecipientId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x04R\agroupId\x12\x1d\n" +
+	"\x04type\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04type\x12\"\n" +
+	"\apayload\x18\x04 \x01(\fB\b\xfaB\x05z\x03\x18\x80\bR\apayload\"\xb3\x01\n" +
+	"\vSignalEvent\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\x04R\bsenderId\x12!\n" +
+	"\frecipient_id\x18\x02 \x01(\x04R\vrecipientId\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\x04R\agroupId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\fR\apayload\x12\x1b\n" +
+	"\tsend_time\x18\x06 \x01(\x03R\bsendTime*\xc1\x01\n" +
+	"\aCommand\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\v\n" +
+	"\aSIGN_IN\x10\x01\x12\b\n" +
+	"\x04SYNC\x10\x02\x12\r\n" +
+	"\tHEARTBEAT\x10\x03\x12\v\n" +
+	"\aMESSAGE\x10\x04\x12\x12\n" +
+	"\x0eSUBSCRIBE_ROOM\x10\x05\x12\f\n" +
+	"\bREAD_ACK\x10\x06\x12\x10\n" +
+	"\fREAD_RECEIPT\x10\a\x12\n" +
+	"\n" +
+	"\x06RECALL\x10\b\x12\x0f\n" +
+	"\vDELIVER_ACK\x10\t\x12\n" +
+	"\n" +
+	"\x06KICKED\x10\n" +
+	"\x12\r\n" +
+	"\tRECONNECT\x10\v\x12\n" +
+	"\n" +
+	"\x06SIGNAL\x10\f*\xdc\x02\n" +
+	"\tErrorCode\x12\x06\n" +
+	"\x02OK\x10\x00\x12\x0f\n" +
+	"\vERR_UNKNOWN\x10\x01\x12\x18\n" +
+	"\x14ERR_INVALID_ARGUMENT\x10\x02\x12\x17\n" +
+	"\x13ERR_UNAUTHENTICATED\x10\x03\x12\x19\n" +
+	"\x15ERR_PERMISSION_DENIED\x10\x04\x12\x11\n" +
+	"\rERR_NOT_FOUND\x10\x05\x12\x16\n" +
+	"\x12ERR_ALREADY_EXISTS\x10\x06\x12\x1a\n" +
+	"\x16ERR_RESOURCE_EXHAUSTED\x10\a\x12\x1b\n" +
+	"\x17ERR_FAILED_PRECONDITION\x10\b\x12\x13\n" +
+	"\x0fERR_UNAVAILABLE\x10\t\x12\x19\n" +
+	"\x15ERR_DEADLINE_EXCEEDED\x10\n" +
+	"\x12\x10\n" +
+	"\fERR_INTERNAL\x10\v\x12\x12\n" +
+	"\x0eERR_BAD_PACKET\x10d\x12\x15\n" +
+	"\x11ERR_NOT_SIGNED_IN\x10e\x12\x17\n" +
+	"\x13ERR_UNKNOWN_COMMAND\x10fB%Z#im-server/pkg/protocol/pb/connectpbb\x06proto3"
 
 var (
 	file_pkg_protocol_proto_connect_connect_ext_proto_rawDescOnce sync.Once
@@ -253,20 +1222,41 @@ func file_pkg_protocol_proto_connect_connect_ext_proto_rawDescGZIP() []byte {
 	return file_pkg_protocol_proto_connect_connect_ext_proto_rawDescData
 }
 
-var file_pkg_protocol_proto_connect_connect_ext_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_protocol_proto_connect_connect_ext_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pkg_protocol_proto_connect_connect_ext_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_pkg_protocol_proto_connect_connect_ext_proto_goTypes = []any{
-	(Command)(0),        // 0: connect.Command
-	(*Packet)(nil),      // 1: connect.Packet
-	(*SignInInput)(nil), // 2: connect.SignInInput
+	(Command)(0),                     // 0: connect.Command
+	(ErrorCode)(0),                   // 1: connect.ErrorCode
+	(*Packet)(nil),                   // 2: connect.Packet
+	(*SignInInput)(nil),              // 3: connect.SignInInput
+	(*SyncInput)(nil),                // 4: connect.SyncInput
+	(*HeartbeatOutput)(nil),          // 5: connect.HeartbeatOutput
+	(*MessageEvent)(nil),             // 6: connect.MessageEvent
+	(*SubscribeRoomInput)(nil),       // 7: connect.SubscribeRoomInput
+	(*ReadAckInput)(nil),             // 8: connect.ReadAckInput
+	(*ReadReceipt)(nil),              // 9: connect.ReadReceipt
+	(*RecallNotice)(nil),             // 10: connect.RecallNotice
+	(*DeliverAckInput)(nil),          // 11: connect.DeliverAckInput
+	(*KickedNotice)(nil),             // 12: connect.KickedNotice
+	(*ReconnectNotice)(nil),          // 13: connect.ReconnectNotice
+	(*SignalInput)(nil),              // 14: connect.SignalInput
+	(*SignalEvent)(nil),              // 15: connect.SignalEvent
+	(*messagepb.SyncCursor)(nil),     // 16: message.SyncCursor
+	(*messagepb.PeerInfo)(nil),       // 17: message.PeerInfo
+	(*messagepb.MessageContent)(nil), // 18: message.MessageContent
+	(*messagepb.QuotedMessage)(nil),  // 19: message.QuotedMessage
 }
 var file_pkg_protocol_proto_connect_connect_ext_proto_depIdxs = []int32{
-	0, // 0: connect.Packet.command:type_name -> connect.Command
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: connect.Packet.command:type_name -> connect.Command
+	16, // 1: connect.SyncInput.cursors:type_name -> message.SyncCursor
+	17, // 2: connect.MessageEvent.sender:type_name -> message.PeerInfo
+	18, // 3: connect.MessageEvent.content:type_name -> message.MessageContent
+	19, // 4: connect.MessageEvent.reply_to:type_name -> message.QuotedMessage
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_pkg_protocol_proto_connect_connect_ext_proto_init() }
@@ -279,8 +1269,8 @@ func file_pkg_protocol_proto_connect_connect_ext_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_proto_connect_connect_ext_proto_rawDesc), len(file_pkg_protocol_proto_connect_connect_ext_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
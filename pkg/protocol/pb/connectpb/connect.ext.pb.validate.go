@@ -247,3 +247,1410 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SignInInputValidationError{}
+
+// Validate checks the field values on SyncInput with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SyncInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SyncInputMultiError, or nil
+// if none found.
+func (m *SyncInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCursors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncInputValidationError{
+						field:  fmt.Sprintf("Cursors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncInputValidationError{
+						field:  fmt.Sprintf("Cursors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncInputValidationError{
+					field:  fmt.Sprintf("Cursors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for InboxCursor
+
+	if len(errors) > 0 {
+		return SyncInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncInputMultiError is an error wrapping multiple validation errors returned
+// by SyncInput.ValidateAll() if the designated constraints aren't met.
+type SyncInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncInputMultiError) AllErrors() []error { return m }
+
+// SyncInputValidationError is the validation error returned by
+// SyncInput.Validate if the designated constraints aren't met.
+type SyncInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncInputValidationError) ErrorName() string { return "SyncInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SyncInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncInputValidationError{}
+
+// Validate checks the field values on HeartbeatOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HeartbeatOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HeartbeatOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HeartbeatOutputMultiError, or nil if none found.
+func (m *HeartbeatOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HeartbeatOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServerTime
+
+	// no validation rules for HeartbeatInterval
+
+	if len(errors) > 0 {
+		return HeartbeatOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// HeartbeatOutputMultiError is an error wrapping multiple validation errors
+// returned by HeartbeatOutput.ValidateAll() if the designated constraints
+// aren't met.
+type HeartbeatOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HeartbeatOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HeartbeatOutputMultiError) AllErrors() []error { return m }
+
+// HeartbeatOutputValidationError is the validation error returned by
+// HeartbeatOutput.Validate if the designated constraints aren't met.
+type HeartbeatOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HeartbeatOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HeartbeatOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HeartbeatOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HeartbeatOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HeartbeatOutputValidationError) ErrorName() string { return "HeartbeatOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HeartbeatOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHeartbeatOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HeartbeatOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HeartbeatOutputValidationError{}
+
+// Validate checks the field values on MessageEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageEventMultiError, or
+// nil if none found.
+func (m *MessageEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for ConversationType
+
+	// no validation rules for GroupId
+
+	// no validation rules for Seq
+
+	if all {
+		switch v := interface{}(m.GetSender()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Sender",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Sender",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSender()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEventValidationError{
+				field:  "Sender",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RecipientId
+
+	// no validation rules for MessageType
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEventValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReplyTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "ReplyTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "ReplyTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplyTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEventValidationError{
+				field:  "ReplyTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SendTime
+
+	// no validation rules for ClientMsgId
+
+	if len(errors) > 0 {
+		return MessageEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageEventMultiError is an error wrapping multiple validation errors
+// returned by MessageEvent.ValidateAll() if the designated constraints aren't met.
+type MessageEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageEventMultiError) AllErrors() []error { return m }
+
+// MessageEventValidationError is the validation error returned by
+// MessageEvent.Validate if the designated constraints aren't met.
+type MessageEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageEventValidationError) ErrorName() string { return "MessageEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageEventValidationError{}
+
+// Validate checks the field values on SubscribeRoomInput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubscribeRoomInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeRoomInput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscribeRoomInputMultiError, or nil if none found.
+func (m *SubscribeRoomInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeRoomInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	if len(errors) > 0 {
+		return SubscribeRoomInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscribeRoomInputMultiError is an error wrapping multiple validation errors
+// returned by SubscribeRoomInput.ValidateAll() if the designated constraints
+// aren't met.
+type SubscribeRoomInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscribeRoomInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscribeRoomInputMultiError) AllErrors() []error { return m }
+
+// SubscribeRoomInputValidationError is the validation error returned by
+// SubscribeRoomInput.Validate if the designated constraints aren't met.
+type SubscribeRoomInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscribeRoomInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscribeRoomInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeRoomInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeRoomInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeRoomInputValidationError) ErrorName() string {
+	return "SubscribeRoomInputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubscribeRoomInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribeRoomInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeRoomInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeRoomInputValidationError{}
+
+// Validate checks the field values on ReadAckInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReadAckInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadAckInput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReadAckInputMultiError, or
+// nil if none found.
+func (m *ReadAckInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadAckInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConversationId
+
+	// no validation rules for ReadSeq
+
+	if len(errors) > 0 {
+		return ReadAckInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadAckInputMultiError is an error wrapping multiple validation errors
+// returned by ReadAckInput.ValidateAll() if the designated constraints aren't met.
+type ReadAckInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadAckInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadAckInputMultiError) AllErrors() []error { return m }
+
+// ReadAckInputValidationError is the validation error returned by
+// ReadAckInput.Validate if the designated constraints aren't met.
+type ReadAckInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadAckInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadAckInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadAckInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadAckInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadAckInputValidationError) ErrorName() string { return "ReadAckInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadAckInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadAckInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadAckInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadAckInputValidationError{}
+
+// Validate checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReadReceipt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReadReceiptMultiError, or
+// nil if none found.
+func (m *ReadReceipt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadReceipt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConversationId
+
+	// no validation rules for ReaderId
+
+	// no validation rules for ReadSeq
+
+	if len(errors) > 0 {
+		return ReadReceiptMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadReceiptMultiError is an error wrapping multiple validation errors
+// returned by ReadReceipt.ValidateAll() if the designated constraints aren't met.
+type ReadReceiptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadReceiptMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadReceiptMultiError) AllErrors() []error { return m }
+
+// ReadReceiptValidationError is the validation error returned by
+// ReadReceipt.Validate if the designated constraints aren't met.
+type ReadReceiptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadReceiptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadReceiptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadReceiptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadReceiptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadReceiptValidationError) ErrorName() string { return "ReadReceiptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadReceiptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadReceipt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadReceiptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadReceiptValidationError{}
+
+// Validate checks the field values on RecallNotice with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecallNotice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecallNotice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecallNoticeMultiError, or
+// nil if none found.
+func (m *RecallNotice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecallNotice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConversationId
+
+	// no validation rules for MessageId
+
+	// no validation rules for Seq
+
+	// no validation rules for OperatorId
+
+	if len(errors) > 0 {
+		return RecallNoticeMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecallNoticeMultiError is an error wrapping multiple validation errors
+// returned by RecallNotice.ValidateAll() if the designated constraints aren't met.
+type RecallNoticeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecallNoticeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecallNoticeMultiError) AllErrors() []error { return m }
+
+// RecallNoticeValidationError is the validation error returned by
+// RecallNotice.Validate if the designated constraints aren't met.
+type RecallNoticeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecallNoticeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecallNoticeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecallNoticeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecallNoticeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecallNoticeValidationError) ErrorName() string { return "RecallNoticeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecallNoticeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecallNotice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecallNoticeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecallNoticeValidationError{}
+
+// Validate checks the field values on DeliverAckInput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeliverAckInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeliverAckInput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeliverAckInputMultiError, or nil if none found.
+func (m *DeliverAckInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeliverAckInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConversationId
+
+	// no validation rules for Seq
+
+	if len(errors) > 0 {
+		return DeliverAckInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeliverAckInputMultiError is an error wrapping multiple validation errors
+// returned by DeliverAckInput.ValidateAll() if the designated constraints
+// aren't met.
+type DeliverAckInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeliverAckInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeliverAckInputMultiError) AllErrors() []error { return m }
+
+// DeliverAckInputValidationError is the validation error returned by
+// DeliverAckInput.Validate if the designated constraints aren't met.
+type DeliverAckInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeliverAckInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeliverAckInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeliverAckInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeliverAckInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeliverAckInputValidationError) ErrorName() string { return "DeliverAckInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeliverAckInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeliverAckInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeliverAckInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeliverAckInputValidationError{}
+
+// Validate checks the field values on KickedNotice with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KickedNotice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KickedNotice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KickedNoticeMultiError, or
+// nil if none found.
+func (m *KickedNotice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KickedNotice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return KickedNoticeMultiError(errors)
+	}
+
+	return nil
+}
+
+// KickedNoticeMultiError is an error wrapping multiple validation errors
+// returned by KickedNotice.ValidateAll() if the designated constraints aren't met.
+type KickedNoticeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KickedNoticeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KickedNoticeMultiError) AllErrors() []error { return m }
+
+// KickedNoticeValidationError is the validation error returned by
+// KickedNotice.Validate if the designated constraints aren't met.
+type KickedNoticeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KickedNoticeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KickedNoticeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KickedNoticeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KickedNoticeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KickedNoticeValidationError) ErrorName() string { return "KickedNoticeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KickedNoticeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKickedNotice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KickedNoticeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KickedNoticeValidationError{}
+
+// Validate checks the field values on ReconnectNotice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReconnectNotice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReconnectNotice with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReconnectNoticeMultiError, or nil if none found.
+func (m *ReconnectNotice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReconnectNotice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RetryAfterMs
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ReconnectNoticeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReconnectNoticeMultiError is an error wrapping multiple validation errors
+// returned by ReconnectNotice.ValidateAll() if the designated constraints
+// aren't met.
+type ReconnectNoticeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReconnectNoticeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReconnectNoticeMultiError) AllErrors() []error { return m }
+
+// ReconnectNoticeValidationError is the validation error returned by
+// ReconnectNotice.Validate if the designated constraints aren't met.
+type ReconnectNoticeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReconnectNoticeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReconnectNoticeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReconnectNoticeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReconnectNoticeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReconnectNoticeValidationError) ErrorName() string { return "ReconnectNoticeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReconnectNoticeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReconnectNotice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReconnectNoticeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReconnectNoticeValidationError{}
+
+// Validate checks the field values on SignalInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SignalInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignalInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SignalInputMultiError, or
+// nil if none found.
+func (m *SignalInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignalInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RecipientId
+
+	// no validation rules for GroupId
+
+	if l := utf8.RuneCountInString(m.GetType()); l < 1 || l > 32 {
+		err := SignalInputValidationError{
+			field:  "Type",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPayload()) > 1024 {
+		err := SignalInputValidationError{
+			field:  "Payload",
+			reason: "value length must be at most 1024 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SignalInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignalInputMultiError is an error wrapping multiple validation errors
+// returned by SignalInput.ValidateAll() if the designated constraints aren't met.
+type SignalInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignalInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignalInputMultiError) AllErrors() []error { return m }
+
+// SignalInputValidationError is the validation error returned by
+// SignalInput.Validate if the designated constraints aren't met.
+type SignalInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignalInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignalInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignalInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignalInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignalInputValidationError) ErrorName() string { return "SignalInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SignalInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignalInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignalInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignalInputValidationError{}
+
+// Validate checks the field values on SignalEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SignalEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignalEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SignalEventMultiError, or
+// nil if none found.
+func (m *SignalEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignalEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SenderId
+
+	// no validation rules for RecipientId
+
+	// no validation rules for GroupId
+
+	// no validation rules for Type
+
+	// no validation rules for Payload
+
+	// no validation rules for SendTime
+
+	if len(errors) > 0 {
+		return SignalEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignalEventMultiError is an error wrapping multiple validation errors
+// returned by SignalEvent.ValidateAll() if the designated constraints aren't met.
+type SignalEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignalEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignalEventMultiError) AllErrors() []error { return m }
+
+// SignalEventValidationError is the validation error returned by
+// SignalEvent.Validate if the designated constraints aren't met.
+type SignalEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignalEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignalEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignalEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignalEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignalEventValidationError) ErrorName() string { return "SignalEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SignalEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignalEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignalEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignalEventValidationError{}
@@ -0,0 +1,562 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v3.21.12
+// source: pkg/protocol/proto/connect/connect.int.proto
+
+package connectpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeliverToDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceIds     []uint64               `protobuf:"varint,1,rep,packed,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"` // 本节点上的设备id
+	Packet        *Packet                `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet,omitempty"`                                // 待投递的数据包，close_after 时须为空
+	CloseAfter    bool                   `protobuf:"varint,3,opt,name=close_after,json=closeAfter,proto3" json:"close_after,omitempty"`     // 踢下线：按 kick_reason 下发 KICKED 通知后关闭连接
+	KickReason    string                 `protobuf:"bytes,4,opt,name=kick_reason,json=kickReason,proto3" json:"kick_reason,omitempty"`      // 下线原因，仅 close_after 时有效
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliverToDevicesRequest) Reset() {
+	*x = DeliverToDevicesRequest{}
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliverToDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverToDevicesRequest) ProtoMessage() {}
+
+func (x *DeliverToDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverToDevicesRequest.ProtoReflect.Descriptor instead.
+func (*DeliverToDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_int_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeliverToDevicesRequest) GetDeviceIds() []uint64 {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+func (x *DeliverToDevicesRequest) GetPacket() *Packet {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+func (x *DeliverToDevicesRequest) GetCloseAfter() bool {
+	if x != nil {
+		return x.CloseAfter
+	}
+	return false
+}
+
+func (x *DeliverToDevicesRequest) GetKickReason() string {
+	if x != nil {
+		return x.KickReason
+	}
+	return ""
+}
+
+type DeliverToDevicesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivered     uint32                 `protobuf:"varint,1,opt,name=delivered,proto3" json:"delivered,omitempty"` // 成功写出的设备数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliverToDevicesReply) Reset() {
+	*x = DeliverToDevicesReply{}
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliverToDevicesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverToDevicesReply) ProtoMessage() {}
+
+func (x *DeliverToDevicesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverToDevicesReply.ProtoReflect.Descriptor instead.
+func (*DeliverToDevicesReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_int_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeliverToDevicesReply) GetDelivered() uint32 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+type PushToDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 设备所属用户id，用于定位设备所在节点
+	DeviceId      uint64                 `protobuf:"varint,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 设备id
+	Packet        *Packet                `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet,omitempty"`                      // 待推送的数据包
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushToDeviceRequest) Reset() {
+	*x = PushToDeviceRequest{}
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushToDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushToDeviceRequest) ProtoMessage() {}
+
+func (x *PushToDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushToDeviceRequest.ProtoReflect.Descriptor instead.
+func (*PushToDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_int_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PushToDeviceRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PushToDeviceRequest) GetDeviceId() uint64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *PushToDeviceRequest) GetPacket() *Packet {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+type PushToUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []uint64               `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // 用户id列表
+	Packet        *Packet                `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet,omitempty"`                          // 待推送的数据包
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushToUserRequest) Reset() {
+	*x = PushToUserRequest{}
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushToUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushToUserRequest) ProtoMessage() {}
+
+func (x *PushToUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushToUserRequest.ProtoReflect.Descriptor instead.
+func (*PushToUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_int_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PushToUserRequest) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *PushToUserRequest) GetPacket() *Packet {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+type PushToRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"` // 房间id
+	Packet        *Packet                `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet,omitempty"`                // 待推送的数据包
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushToRoomRequest) Reset() {
+	*x = PushToRoomRequest{}
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushToRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushToRoomRequest) ProtoMessage() {}
+
+func (x *PushToRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushToRoomRequest.ProtoReflect.Descriptor instead.
+func (*PushToRoomRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_int_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PushToRoomRequest) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *PushToRoomRequest) GetPacket() *Packet {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+type PushReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivered     uint32                 `protobuf:"varint,1,opt,name=delivered,proto3" json:"delivered,omitempty"` // 成功写出的设备数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushReply) Reset() {
+	*x = PushReply{}
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushReply) ProtoMessage() {}
+
+func (x *PushReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushReply.ProtoReflect.Descriptor instead.
+func (*PushReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_int_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PushReply) GetDelivered() uint32 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+type KickDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 设备所属用户id，用于定位设备所在节点
+	DeviceId      uint64                 `protobuf:"varint,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 设备id
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                      // 下线原因，随 KICKED 通知下发给客户端
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickDeviceRequest) Reset() {
+	*x = KickDeviceRequest{}
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickDeviceRequest) ProtoMessage() {}
+
+func (x *KickDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickDeviceRequest.ProtoReflect.Descriptor instead.
+func (*KickDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_int_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KickDeviceRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *KickDeviceRequest) GetDeviceId() uint64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *KickDeviceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type KickDeviceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kicked        bool                   `protobuf:"varint,1,opt,name=kicked,proto3" json:"kicked,omitempty"` // 设备是否在线并已被踢下线
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickDeviceReply) Reset() {
+	*x = KickDeviceReply{}
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickDeviceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickDeviceReply) ProtoMessage() {}
+
+func (x *KickDeviceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_connect_connect_int_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickDeviceReply.ProtoReflect.Descriptor instead.
+func (*KickDeviceReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_connect_connect_int_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KickDeviceReply) GetKicked() bool {
+	if x != nil {
+		return x.Kicked
+	}
+	return false
+}
+
+var File_pkg_protocol_proto_connect_connect_int_proto protoreflect.FileDescriptor
+
+const file_pkg_protocol_proto_connect_connect_int_proto_rawDesc = "" +
+	"\n" +
+	",pkg/protocol/proto/connect/connect.int.proto\x12\aconnect\x1a\x1bgoogle/protobuf/empty.proto\x1a,pkg/protocol/proto/connect/connect.ext.proto\"\xa3\x01\n" +
+	"\x17DeliverToDevicesRequest\x12\x1d\n" +
+	"\n" +
+	"device_ids\x18\x01 \x03(\x04R\tdeviceIds\x12'\n" +
+	"\x06packet\x18\x02 \x01(\v2\x0f.connect.PacketR\x06packet\x12\x1f\n" +
+	"\vclose_after\x18\x03 \x01(\bR\n" +
+	"closeAfter\x12\x1f\n" +
+	"\vkick_reason\x18\x04 \x01(\tR\n" +
+	"kickReason\"5\n" +
+	"\x15DeliverToDevicesReply\x12\x1c\n" +
+	"\tdelivered\x18\x01 \x01(\rR\tdelivered\"t\n" +
+	"\x13PushToDeviceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\x04R\bdeviceId\x12'\n" +
+	"\x06packet\x18\x03 \x01(\v2\x0f.connect.PacketR\x06packet\"W\n" +
+	"\x11PushToUserRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x04R\auserIds\x12'\n" +
+	"\x06packet\x18\x02 \x01(\v2\x0f.connect.PacketR\x06packet\"U\n" +
+	"\x11PushToRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x04R\x06roomId\x12'\n" +
+	"\x06packet\x18\x02 \x01(\v2\x0f.connect.PacketR\x06packet\")\n" +
+	"\tPushReply\x12\x1c\n" +
+	"\tdelivered\x18\x01 \x01(\rR\tdelivered\"a\n" +
+	"\x11KickDeviceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\x04R\bdeviceId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\")\n" +
+	"\x0fKickDeviceReply\x12\x16\n" +
+	"\x06kicked\x18\x01 \x01(\bR\x06kicked2\xef\x02\n" +
+	"\x11ConnectIntService\x12T\n" +
+	"\x10DeliverToDevices\x12 .connect.DeliverToDevicesRequest\x1a\x1e.connect.DeliverToDevicesReply\x12@\n" +
+	"\fPushToDevice\x12\x1c.connect.PushToDeviceRequest\x1a\x12.connect.PushReply\x12<\n" +
+	"\n" +
+	"PushToUser\x12\x1a.connect.PushToUserRequest\x1a\x12.connect.PushReply\x12@\n" +
+	"\n" +
+	"PushToRoom\x12\x1a.connect.PushToRoomRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\n" +
+	"KickDevice\x12\x1a.connect.KickDeviceRequest\x1a\x18.connect.KickDeviceReplyB%Z#im-server/pkg/protocol/pb/connectpbb\x06proto3"
+
+var (
+	file_pkg_protocol_proto_connect_connect_int_proto_rawDescOnce sync.Once
+	file_pkg_protocol_proto_connect_connect_int_proto_rawDescData []byte
+)
+
+func file_pkg_protocol_proto_connect_connect_int_proto_rawDescGZIP() []byte {
+	file_pkg_protocol_proto_connect_connect_int_proto_rawDescOnce.Do(func() {
+		file_pkg_protocol_proto_connect_connect_int_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_protocol_proto_connect_connect_int_proto_rawDesc), len(file_pkg_protocol_proto_connect_connect_int_proto_rawDesc)))
+	})
+	return file_pkg_protocol_proto_connect_connect_int_proto_rawDescData
+}
+
+var file_pkg_protocol_proto_connect_connect_int_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_pkg_protocol_proto_connect_connect_int_proto_goTypes = []any{
+	(*DeliverToDevicesRequest)(nil), // 0: connect.DeliverToDevicesRequest
+	(*DeliverToDevicesReply)(nil),   // 1: connect.DeliverToDevicesReply
+	(*PushToDeviceRequest)(nil),     // 2: connect.PushToDeviceRequest
+	(*PushToUserRequest)(nil),       // 3: connect.PushToUserRequest
+	(*PushToRoomRequest)(nil),       // 4: connect.PushToRoomRequest
+	(*PushReply)(nil),               // 5: connect.PushReply
+	(*KickDeviceRequest)(nil),       // 6: connect.KickDeviceRequest
+	(*KickDeviceReply)(nil),         // 7: connect.KickDeviceReply
+	(*Packet)(nil),                  // 8: connect.Packet
+	(*emptypb.Empty)(nil),           // 9: google.protobuf.Empty
+}
+var file_pkg_protocol_proto_connect_connect_int_proto_depIdxs = []int32{
+	8, // 0: connect.DeliverToDevicesRequest.packet:type_name -> connect.Packet
+	8, // 1: connect.PushToDeviceRequest.packet:type_name -> connect.Packet
+	8, // 2: connect.PushToUserRequest.packet:type_name -> connect.Packet
+	8, // 3: connect.PushToRoomRequest.packet:type_name -> connect.Packet
+	0, // 4: connect.ConnectIntService.DeliverToDevices:input_type -> connect.DeliverToDevicesRequest
+	2, // 5: connect.ConnectIntService.PushToDevice:input_type -> connect.PushToDeviceRequest
+	3, // 6: connect.ConnectIntService.PushToUser:input_type -> connect.PushToUserRequest
+	4, // 7: connect.ConnectIntService.PushToRoom:input_type -> connect.PushToRoomRequest
+	6, // 8: connect.ConnectIntService.KickDevice:input_type -> connect.KickDeviceRequest
+	1, // 9: connect.ConnectIntService.DeliverToDevices:output_type -> connect.DeliverToDevicesReply
+	5, // 10: connect.ConnectIntService.PushToDevice:output_type -> connect.PushReply
+	5, // 11: connect.ConnectIntService.PushToUser:output_type -> connect.PushReply
+	9, // 12: connect.ConnectIntService.PushToRoom:output_type -> google.protobuf.Empty
+	7, // 13: connect.ConnectIntService.KickDevice:output_type -> connect.KickDeviceReply
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_pkg_protocol_proto_connect_connect_int_proto_init() }
+func file_pkg_protocol_proto_connect_connect_int_proto_init() {
+	if File_pkg_protocol_proto_connect_connect_int_proto != nil {
+		return
+	}
+	file_pkg_protocol_proto_connect_connect_ext_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_proto_connect_connect_int_proto_rawDesc), len(file_pkg_protocol_proto_connect_connect_int_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_protocol_proto_connect_connect_int_proto_goTypes,
+		DependencyIndexes: file_pkg_protocol_proto_connect_connect_int_proto_depIdxs,
+		MessageInfos:      file_pkg_protocol_proto_connect_connect_int_proto_msgTypes,
+	}.Build()
+	File_pkg_protocol_proto_connect_connect_int_proto = out.File
+	file_pkg_protocol_proto_connect_connect_int_proto_goTypes = nil
+	file_pkg_protocol_proto_connect_connect_int_proto_depIdxs = nil
+}
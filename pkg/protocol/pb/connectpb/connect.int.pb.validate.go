@@ -0,0 +1,985 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/protocol/proto/connect/connect.int.proto
+
+package connectpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DeliverToDevicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeliverToDevicesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeliverToDevicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeliverToDevicesRequestMultiError, or nil if none found.
+func (m *DeliverToDevicesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeliverToDevicesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPacket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeliverToDevicesRequestValidationError{
+					field:  "Packet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeliverToDevicesRequestValidationError{
+					field:  "Packet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPacket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeliverToDevicesRequestValidationError{
+				field:  "Packet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CloseAfter
+
+	// no validation rules for KickReason
+
+	if len(errors) > 0 {
+		return DeliverToDevicesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeliverToDevicesRequestMultiError is an error wrapping multiple validation
+// errors returned by DeliverToDevicesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeliverToDevicesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeliverToDevicesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeliverToDevicesRequestMultiError) AllErrors() []error { return m }
+
+// DeliverToDevicesRequestValidationError is the validation error returned by
+// DeliverToDevicesRequest.Validate if the designated constraints aren't met.
+type DeliverToDevicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeliverToDevicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeliverToDevicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeliverToDevicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeliverToDevicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeliverToDevicesRequestValidationError) ErrorName() string {
+	return "DeliverToDevicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeliverToDevicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeliverToDevicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeliverToDevicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeliverToDevicesRequestValidationError{}
+
+// Validate checks the field values on DeliverToDevicesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeliverToDevicesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeliverToDevicesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeliverToDevicesReplyMultiError, or nil if none found.
+func (m *DeliverToDevicesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeliverToDevicesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Delivered
+
+	if len(errors) > 0 {
+		return DeliverToDevicesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeliverToDevicesReplyMultiError is an error wrapping multiple validation
+// errors returned by DeliverToDevicesReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeliverToDevicesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeliverToDevicesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeliverToDevicesReplyMultiError) AllErrors() []error { return m }
+
+// DeliverToDevicesReplyValidationError is the validation error returned by
+// DeliverToDevicesReply.Validate if the designated constraints aren't met.
+type DeliverToDevicesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeliverToDevicesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeliverToDevicesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeliverToDevicesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeliverToDevicesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeliverToDevicesReplyValidationError) ErrorName() string {
+	return "DeliverToDevicesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeliverToDevicesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeliverToDevicesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeliverToDevicesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeliverToDevicesReplyValidationError{}
+
+// Validate checks the field values on PushToDeviceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PushToDeviceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushToDeviceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PushToDeviceRequestMultiError, or nil if none found.
+func (m *PushToDeviceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushToDeviceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for DeviceId
+
+	if all {
+		switch v := interface{}(m.GetPacket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PushToDeviceRequestValidationError{
+					field:  "Packet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PushToDeviceRequestValidationError{
+					field:  "Packet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPacket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PushToDeviceRequestValidationError{
+				field:  "Packet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PushToDeviceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushToDeviceRequestMultiError is an error wrapping multiple validation
+// errors returned by PushToDeviceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PushToDeviceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushToDeviceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushToDeviceRequestMultiError) AllErrors() []error { return m }
+
+// PushToDeviceRequestValidationError is the validation error returned by
+// PushToDeviceRequest.Validate if the designated constraints aren't met.
+type PushToDeviceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushToDeviceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushToDeviceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushToDeviceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushToDeviceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushToDeviceRequestValidationError) ErrorName() string {
+	return "PushToDeviceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PushToDeviceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushToDeviceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushToDeviceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushToDeviceRequestValidationError{}
+
+// Validate checks the field values on PushToUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PushToUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushToUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PushToUserRequestMultiError, or nil if none found.
+func (m *PushToUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushToUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPacket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PushToUserRequestValidationError{
+					field:  "Packet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PushToUserRequestValidationError{
+					field:  "Packet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPacket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PushToUserRequestValidationError{
+				field:  "Packet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PushToUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushToUserRequestMultiError is an error wrapping multiple validation errors
+// returned by PushToUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PushToUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushToUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushToUserRequestMultiError) AllErrors() []error { return m }
+
+// PushToUserRequestValidationError is the validation error returned by
+// PushToUserRequest.Validate if the designated constraints aren't met.
+type PushToUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushToUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushToUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushToUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushToUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushToUserRequestValidationError) ErrorName() string {
+	return "PushToUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PushToUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushToUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushToUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushToUserRequestValidationError{}
+
+// Validate checks the field values on PushToRoomRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PushToRoomRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushToRoomRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PushToRoomRequestMultiError, or nil if none found.
+func (m *PushToRoomRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushToRoomRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	if all {
+		switch v := interface{}(m.GetPacket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PushToRoomRequestValidationError{
+					field:  "Packet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PushToRoomRequestValidationError{
+					field:  "Packet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPacket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PushToRoomRequestValidationError{
+				field:  "Packet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PushToRoomRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushToRoomRequestMultiError is an error wrapping multiple validation errors
+// returned by PushToRoomRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PushToRoomRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushToRoomRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushToRoomRequestMultiError) AllErrors() []error { return m }
+
+// PushToRoomRequestValidationError is the validation error returned by
+// PushToRoomRequest.Validate if the designated constraints aren't met.
+type PushToRoomRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushToRoomRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushToRoomRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushToRoomRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushToRoomRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushToRoomRequestValidationError) ErrorName() string {
+	return "PushToRoomRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PushToRoomRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushToRoomRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushToRoomRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushToRoomRequestValidationError{}
+
+// Validate checks the field values on PushReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PushReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PushReplyMultiError, or nil
+// if none found.
+func (m *PushReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Delivered
+
+	if len(errors) > 0 {
+		return PushReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushReplyMultiError is an error wrapping multiple validation errors returned
+// by PushReply.ValidateAll() if the designated constraints aren't met.
+type PushReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushReplyMultiError) AllErrors() []error { return m }
+
+// PushReplyValidationError is the validation error returned by
+// PushReply.Validate if the designated constraints aren't met.
+type PushReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushReplyValidationError) ErrorName() string { return "PushReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PushReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushReplyValidationError{}
+
+// Validate checks the field values on KickDeviceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *KickDeviceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KickDeviceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KickDeviceRequestMultiError, or nil if none found.
+func (m *KickDeviceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KickDeviceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for DeviceId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return KickDeviceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// KickDeviceRequestMultiError is an error wrapping multiple validation errors
+// returned by KickDeviceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type KickDeviceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KickDeviceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KickDeviceRequestMultiError) AllErrors() []error { return m }
+
+// KickDeviceRequestValidationError is the validation error returned by
+// KickDeviceRequest.Validate if the designated constraints aren't met.
+type KickDeviceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KickDeviceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KickDeviceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KickDeviceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KickDeviceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KickDeviceRequestValidationError) ErrorName() string {
+	return "KickDeviceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KickDeviceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKickDeviceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KickDeviceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KickDeviceRequestValidationError{}
+
+// Validate checks the field values on KickDeviceReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *KickDeviceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KickDeviceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KickDeviceReplyMultiError, or nil if none found.
+func (m *KickDeviceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KickDeviceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kicked
+
+	if len(errors) > 0 {
+		return KickDeviceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// KickDeviceReplyMultiError is an error wrapping multiple validation errors
+// returned by KickDeviceReply.ValidateAll() if the designated constraints
+// aren't met.
+type KickDeviceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KickDeviceReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KickDeviceReplyMultiError) AllErrors() []error { return m }
+
+// KickDeviceReplyValidationError is the validation error returned by
+// KickDeviceReply.Validate if the designated constraints aren't met.
+type KickDeviceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KickDeviceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KickDeviceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KickDeviceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KickDeviceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KickDeviceReplyValidationError) ErrorName() string { return "KickDeviceReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KickDeviceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKickDeviceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KickDeviceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KickDeviceReplyValidationError{}
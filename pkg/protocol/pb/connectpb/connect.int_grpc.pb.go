@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: pkg/protocol/proto/connect/connect.int.proto
+
+package connectpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ConnectIntService_DeliverToDevices_FullMethodName = "/connect.ConnectIntService/DeliverToDevices"
+	ConnectIntService_PushToDevice_FullMethodName     = "/connect.ConnectIntService/PushToDevice"
+	ConnectIntService_PushToUser_FullMethodName       = "/connect.ConnectIntService/PushToUser"
+	ConnectIntService_PushToRoom_FullMethodName       = "/connect.ConnectIntService/PushToRoom"
+	ConnectIntService_KickDevice_FullMethodName       = "/connect.ConnectIntService/KickDevice"
+)
+
+// ConnectIntServiceClient is the client API for ConnectIntService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConnectIntServiceClient interface {
+	// 将 Packet 投递到本节点上的指定设备，由消费到事件的 connect 节点按设备所在节点转发
+	DeliverToDevices(ctx context.Context, in *DeliverToDevicesRequest, opts ...grpc.CallOption) (*DeliverToDevicesReply, error)
+	// 向指定设备推送 Packet，设备不在本节点时转发到其所在节点
+	PushToDevice(ctx context.Context, in *PushToDeviceRequest, opts ...grpc.CallOption) (*PushReply, error)
+	// 向用户的全部在线设备推送 Packet
+	PushToUser(ctx context.Context, in *PushToUserRequest, opts ...grpc.CallOption) (*PushReply, error)
+	// 向房间的全部订阅者推送 Packet，经房间广播主题下发到所有 connect 节点
+	PushToRoom(ctx context.Context, in *PushToRoomRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 强制设备下线，先下发 KICKED 通知再关闭连接
+	KickDevice(ctx context.Context, in *KickDeviceRequest, opts ...grpc.CallOption) (*KickDeviceReply, error)
+}
+
+type connectIntServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConnectIntServiceClient(cc grpc.ClientConnInterface) ConnectIntServiceClient {
+	return &connectIntServiceClient{cc}
+}
+
+func (c *connectIntServiceClient) DeliverToDevices(ctx context.Context, in *DeliverToDevicesRequest, opts ...grpc.CallOption) (*DeliverToDevicesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeliverToDevicesReply)
+	err := c.cc.Invoke(ctx, ConnectIntService_DeliverToDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectIntServiceClient) PushToDevice(ctx context.Context, in *PushToDeviceRequest, opts ...grpc.CallOption) (*PushReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushReply)
+	err := c.cc.Invoke(ctx, ConnectIntService_PushToDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectIntServiceClient) PushToUser(ctx context.Context, in *PushToUserRequest, opts ...grpc.CallOption) (*PushReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushReply)
+	err := c.cc.Invoke(ctx, ConnectIntService_PushToUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectIntServiceClient) PushToRoom(ctx context.Context, in *PushToRoomRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConnectIntService_PushToRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectIntServiceClient) KickDevice(ctx context.Context, in *KickDeviceRequest, opts ...grpc.CallOption) (*KickDeviceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickDeviceReply)
+	err := c.cc.Invoke(ctx, ConnectIntService_KickDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConnectIntServiceServer is the server API for ConnectIntService service.
+// All implementations must embed UnimplementedConnectIntServiceServer
+// for forward compatibility.
+type ConnectIntServiceServer interface {
+	// 将 Packet 投递到本节点上的指定设备，由消费到事件的 connect 节点按设备所在节点转发
+	DeliverToDevices(context.Context, *DeliverToDevicesRequest) (*DeliverToDevicesReply, error)
+	// 向指定设备推送 Packet，设备不在本节点时转发到其所在节点
+	PushToDevice(context.Context, *PushToDeviceRequest) (*PushReply, error)
+	// 向用户的全部在线设备推送 Packet
+	PushToUser(context.Context, *PushToUserRequest) (*PushReply, error)
+	// 向房间的全部订阅者推送 Packet，经房间广播主题下发到所有 connect 节点
+	PushToRoom(context.Context, *PushToRoomRequest) (*emptypb.Empty, error)
+	// 强制设备下线，先下发 KICKED 通知再关闭连接
+	KickDevice(context.Context, *KickDeviceRequest) (*KickDeviceReply, error)
+	mustEmbedUnimplementedConnectIntServiceServer()
+}
+
+// UnimplementedConnectIntServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConnectIntServiceServer struct{}
+
+func (UnimplementedConnectIntServiceServer) DeliverToDevices(context.Context, *DeliverToDevicesRequest) (*DeliverToDevicesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverToDevices not implemented")
+}
+func (UnimplementedConnectIntServiceServer) PushToDevice(context.Context, *PushToDeviceRequest) (*PushReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushToDevice not implemented")
+}
+func (UnimplementedConnectIntServiceServer) PushToUser(context.Context, *PushToUserRequest) (*PushReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushToUser not implemented")
+}
+func (UnimplementedConnectIntServiceServer) PushToRoom(context.Context, *PushToRoomRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushToRoom not implemented")
+}
+func (UnimplementedConnectIntServiceServer) KickDevice(context.Context, *KickDeviceRequest) (*KickDeviceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickDevice not implemented")
+}
+func (UnimplementedConnectIntServiceServer) mustEmbedUnimplementedConnectIntServiceServer() {}
+func (UnimplementedConnectIntServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeConnectIntServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConnectIntServiceServer will
+// result in compilation errors.
+type UnsafeConnectIntServiceServer interface {
+	mustEmbedUnimplementedConnectIntServiceServer()
+}
+
+func RegisterConnectIntServiceServer(s grpc.ServiceRegistrar, srv ConnectIntServiceServer) {
+	// If the following call pancis, it indicates UnimplementedConnectIntServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConnectIntService_ServiceDesc, srv)
+}
+
+func _ConnectIntService_DeliverToDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliverToDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectIntServiceServer).DeliverToDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectIntService_DeliverToDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectIntServiceServer).DeliverToDevices(ctx, req.(*DeliverToDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectIntService_PushToDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushToDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectIntServiceServer).PushToDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectIntService_PushToDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectIntServiceServer).PushToDevice(ctx, req.(*PushToDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectIntService_PushToUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushToUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectIntServiceServer).PushToUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectIntService_PushToUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectIntServiceServer).PushToUser(ctx, req.(*PushToUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectIntService_PushToRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushToRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectIntServiceServer).PushToRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectIntService_PushToRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectIntServiceServer).PushToRoom(ctx, req.(*PushToRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectIntService_KickDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectIntServiceServer).KickDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectIntService_KickDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectIntServiceServer).KickDevice(ctx, req.(*KickDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConnectIntService_ServiceDesc is the grpc.ServiceDesc for ConnectIntService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConnectIntService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.ConnectIntService",
+	HandlerType: (*ConnectIntServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeliverToDevices",
+			Handler:    _ConnectIntService_DeliverToDevices_Handler,
+		},
+		{
+			MethodName: "PushToDevice",
+			Handler:    _ConnectIntService_PushToDevice_Handler,
+		},
+		{
+			MethodName: "PushToUser",
+			Handler:    _ConnectIntService_PushToUser_Handler,
+		},
+		{
+			MethodName: "PushToRoom",
+			Handler:    _ConnectIntService_PushToRoom_Handler,
+		},
+		{
+			MethodName: "KickDevice",
+			Handler:    _ConnectIntService_KickDevice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/protocol/proto/connect/connect.int.proto",
+}
@@ -98,6 +98,102 @@ func (x *ConnSignInRequest) GetClientAddr() string {
 	return ""
 }
 
+type ConnSignInReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Superseded    []*SupersededDevice    `protobuf:"bytes,1,rep,name=superseded,proto3" json:"superseded,omitempty"` // 被本次登录顶替、需要踢下线的设备
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnSignInReply) Reset() {
+	*x = ConnSignInReply{}
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnSignInReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnSignInReply) ProtoMessage() {}
+
+func (x *ConnSignInReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnSignInReply.ProtoReflect.Descriptor instead.
+func (*ConnSignInReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_device_device_int_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConnSignInReply) GetSuperseded() []*SupersededDevice {
+	if x != nil {
+		return x.Superseded
+	}
+	return nil
+}
+
+type SupersededDevice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *OnlineDevice          `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"` // 被顶替的设备及其所在节点
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 下线原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupersededDevice) Reset() {
+	*x = SupersededDevice{}
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupersededDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupersededDevice) ProtoMessage() {}
+
+func (x *SupersededDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupersededDevice.ProtoReflect.Descriptor instead.
+func (*SupersededDevice) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_device_device_int_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SupersededDevice) GetDevice() *OnlineDevice {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *SupersededDevice) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type OfflineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`            // 用户id
@@ -109,7 +205,7 @@ type OfflineRequest struct {
 
 func (x *OfflineRequest) Reset() {
 	*x = OfflineRequest{}
-	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[1]
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -121,7 +217,7 @@ func (x *OfflineRequest) String() string {
 func (*OfflineRequest) ProtoMessage() {}
 
 func (x *OfflineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[1]
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -134,7 +230,7 @@ func (x *OfflineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OfflineRequest.ProtoReflect.Descriptor instead.
 func (*OfflineRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_proto_device_device_int_proto_rawDescGZIP(), []int{1}
+	return file_pkg_protocol_proto_device_device_int_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *OfflineRequest) GetUserId() uint64 {
@@ -158,6 +254,250 @@ func (x *OfflineRequest) GetClientAddr() string {
 	return ""
 }
 
+type BatchOfflineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*OfflineRequest      `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"` // 需要离线的设备
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOfflineRequest) Reset() {
+	*x = BatchOfflineRequest{}
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOfflineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOfflineRequest) ProtoMessage() {}
+
+func (x *BatchOfflineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOfflineRequest.ProtoReflect.Descriptor instead.
+func (*BatchOfflineRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_device_device_int_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchOfflineRequest) GetDevices() []*OfflineRequest {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户id
+	DeviceId      uint64                 `protobuf:"varint,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 设备id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_device_device_int_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HeartbeatRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HeartbeatRequest) GetDeviceId() uint64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+type ListOnlineDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []uint64               `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // 用户id列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnlineDevicesRequest) Reset() {
+	*x = ListOnlineDevicesRequest{}
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnlineDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnlineDevicesRequest) ProtoMessage() {}
+
+func (x *ListOnlineDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnlineDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListOnlineDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_device_device_int_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListOnlineDevicesRequest) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type ListOnlineDevicesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*OnlineDevice        `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnlineDevicesReply) Reset() {
+	*x = ListOnlineDevicesReply{}
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnlineDevicesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnlineDevicesReply) ProtoMessage() {}
+
+func (x *ListOnlineDevicesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnlineDevicesReply.ProtoReflect.Descriptor instead.
+func (*ListOnlineDevicesReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_device_device_int_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListOnlineDevicesReply) GetDevices() []*OnlineDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type OnlineDevice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      uint64                 `protobuf:"varint,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 设备id
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户id
+	ConnAddr      string                 `protobuf:"bytes,3,opt,name=conn_addr,json=connAddr,proto3" json:"conn_addr,omitempty"`  // 设备所在 connect 节点的 RPC 地址
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnlineDevice) Reset() {
+	*x = OnlineDevice{}
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnlineDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnlineDevice) ProtoMessage() {}
+
+func (x *OnlineDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_device_device_int_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnlineDevice.ProtoReflect.Descriptor instead.
+func (*OnlineDevice) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_device_device_int_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OnlineDevice) GetDeviceId() uint64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *OnlineDevice) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OnlineDevice) GetConnAddr() string {
+	if x != nil {
+		return x.ConnAddr
+	}
+	return ""
+}
+
 var File_pkg_protocol_proto_device_device_int_proto protoreflect.FileDescriptor
 
 const file_pkg_protocol_proto_device_device_int_proto_rawDesc = "" +
@@ -169,16 +509,39 @@ const file_pkg_protocol_proto_device_device_int_proto_rawDesc = "" +
 	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1b\n" +
 	"\tconn_addr\x18\x04 \x01(\tR\bconnAddr\x12\x1f\n" +
 	"\vclient_addr\x18\x05 \x01(\tR\n" +
-	"clientAddr\"g\n" +
+	"clientAddr\"K\n" +
+	"\x0fConnSignInReply\x128\n" +
+	"\n" +
+	"superseded\x18\x01 \x03(\v2\x18.device.SupersededDeviceR\n" +
+	"superseded\"X\n" +
+	"\x10SupersededDevice\x12,\n" +
+	"\x06device\x18\x01 \x01(\v2\x14.device.OnlineDeviceR\x06device\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"g\n" +
 	"\x0eOfflineRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\tdevice_id\x18\x02 \x01(\x04R\bdeviceId\x12\x1f\n" +
 	"\vclient_addr\x18\x03 \x01(\tR\n" +
-	"clientAddr2\x8e\x01\n" +
-	"\x10DeviceIntService\x12?\n" +
+	"clientAddr\"G\n" +
+	"\x13BatchOfflineRequest\x120\n" +
+	"\adevices\x18\x01 \x03(\v2\x16.device.OfflineRequestR\adevices\"H\n" +
+	"\x10HeartbeatRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\x04R\bdeviceId\"5\n" +
+	"\x18ListOnlineDevicesRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x04R\auserIds\"H\n" +
+	"\x16ListOnlineDevicesReply\x12.\n" +
+	"\adevices\x18\x01 \x03(\v2\x14.device.OnlineDeviceR\adevices\"a\n" +
+	"\fOnlineDevice\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\x04R\bdeviceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tconn_addr\x18\x03 \x01(\tR\bconnAddr2\xea\x02\n" +
+	"\x10DeviceIntService\x12@\n" +
 	"\n" +
-	"ConnSignIn\x12\x19.device.ConnSignInRequest\x1a\x16.google.protobuf.Empty\x129\n" +
-	"\aOffline\x12\x16.device.OfflineRequest\x1a\x16.google.protobuf.EmptyB\x1aZ\x18pkg/protocol/pb/devicepbb\x06proto3"
+	"ConnSignIn\x12\x19.device.ConnSignInRequest\x1a\x17.device.ConnSignInReply\x129\n" +
+	"\aOffline\x12\x16.device.OfflineRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\tHeartbeat\x12\x18.device.HeartbeatRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\x11ListOnlineDevices\x12 .device.ListOnlineDevicesRequest\x1a\x1e.device.ListOnlineDevicesReply\x12C\n" +
+	"\fBatchOffline\x12\x1b.device.BatchOfflineRequest\x1a\x16.google.protobuf.EmptyB$Z\"im-server/pkg/protocol/pb/devicepbb\x06proto3"
 
 var (
 	file_pkg_protocol_proto_device_device_int_proto_rawDescOnce sync.Once
@@ -192,22 +555,39 @@ func file_pkg_protocol_proto_device_device_int_proto_rawDescGZIP() []byte {
 	return file_pkg_protocol_proto_device_device_int_proto_rawDescData
 }
 
-var file_pkg_protocol_proto_device_device_int_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_protocol_proto_device_device_int_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_pkg_protocol_proto_device_device_int_proto_goTypes = []any{
-	(*ConnSignInRequest)(nil), // 0: device.ConnSignInRequest
-	(*OfflineRequest)(nil),    // 1: device.OfflineRequest
-	(*emptypb.Empty)(nil),     // 2: google.protobuf.Empty
+	(*ConnSignInRequest)(nil),        // 0: device.ConnSignInRequest
+	(*ConnSignInReply)(nil),          // 1: device.ConnSignInReply
+	(*SupersededDevice)(nil),         // 2: device.SupersededDevice
+	(*OfflineRequest)(nil),           // 3: device.OfflineRequest
+	(*BatchOfflineRequest)(nil),      // 4: device.BatchOfflineRequest
+	(*HeartbeatRequest)(nil),         // 5: device.HeartbeatRequest
+	(*ListOnlineDevicesRequest)(nil), // 6: device.ListOnlineDevicesRequest
+	(*ListOnlineDevicesReply)(nil),   // 7: device.ListOnlineDevicesReply
+	(*OnlineDevice)(nil),             // 8: device.OnlineDevice
+	(*emptypb.Empty)(nil),            // 9: google.protobuf.Empty
 }
 var file_pkg_protocol_proto_device_device_int_proto_depIdxs = []int32{
-	0, // 0: device.DeviceIntService.ConnSignIn:input_type -> device.ConnSignInRequest
-	1, // 1: device.DeviceIntService.Offline:input_type -> device.OfflineRequest
-	2, // 2: device.DeviceIntService.ConnSignIn:output_type -> google.protobuf.Empty
-	2, // 3: device.DeviceIntService.Offline:output_type -> google.protobuf.Empty
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: device.ConnSignInReply.superseded:type_name -> device.SupersededDevice
+	8, // 1: device.SupersededDevice.device:type_name -> device.OnlineDevice
+	3, // 2: device.BatchOfflineRequest.devices:type_name -> device.OfflineRequest
+	8, // 3: device.ListOnlineDevicesReply.devices:type_name -> device.OnlineDevice
+	0, // 4: device.DeviceIntService.ConnSignIn:input_type -> device.ConnSignInRequest
+	3, // 5: device.DeviceIntService.Offline:input_type -> device.OfflineRequest
+	5, // 6: device.DeviceIntService.Heartbeat:input_type -> device.HeartbeatRequest
+	6, // 7: device.DeviceIntService.ListOnlineDevices:input_type -> device.ListOnlineDevicesRequest
+	4, // 8: device.DeviceIntService.BatchOffline:input_type -> device.BatchOfflineRequest
+	1, // 9: device.DeviceIntService.ConnSignIn:output_type -> device.ConnSignInReply
+	9, // 10: device.DeviceIntService.Offline:output_type -> google.protobuf.Empty
+	9, // 11: device.DeviceIntService.Heartbeat:output_type -> google.protobuf.Empty
+	7, // 12: device.DeviceIntService.ListOnlineDevices:output_type -> device.ListOnlineDevicesReply
+	9, // 13: device.DeviceIntService.BatchOffline:output_type -> google.protobuf.Empty
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_pkg_protocol_proto_device_device_int_proto_init() }
@@ -221,7 +601,7 @@ func file_pkg_protocol_proto_device_device_int_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_proto_device_device_int_proto_rawDesc), len(file_pkg_protocol_proto_device_device_int_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -147,6 +147,271 @@ var _ interface {
 	ErrorName() string
 } = ConnSignInRequestValidationError{}
 
+// Validate checks the field values on ConnSignInReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConnSignInReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnSignInReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnSignInReplyMultiError, or nil if none found.
+func (m *ConnSignInReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnSignInReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSuperseded() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnSignInReplyValidationError{
+						field:  fmt.Sprintf("Superseded[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnSignInReplyValidationError{
+						field:  fmt.Sprintf("Superseded[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnSignInReplyValidationError{
+					field:  fmt.Sprintf("Superseded[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConnSignInReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnSignInReplyMultiError is an error wrapping multiple validation errors
+// returned by ConnSignInReply.ValidateAll() if the designated constraints
+// aren't met.
+type ConnSignInReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnSignInReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnSignInReplyMultiError) AllErrors() []error { return m }
+
+// ConnSignInReplyValidationError is the validation error returned by
+// ConnSignInReply.Validate if the designated constraints aren't met.
+type ConnSignInReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnSignInReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnSignInReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnSignInReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnSignInReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnSignInReplyValidationError) ErrorName() string { return "ConnSignInReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnSignInReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnSignInReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnSignInReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnSignInReplyValidationError{}
+
+// Validate checks the field values on SupersededDevice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SupersededDevice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SupersededDevice with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SupersededDeviceMultiError, or nil if none found.
+func (m *SupersededDevice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SupersededDevice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDevice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SupersededDeviceValidationError{
+					field:  "Device",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SupersededDeviceValidationError{
+					field:  "Device",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDevice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SupersededDeviceValidationError{
+				field:  "Device",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return SupersededDeviceMultiError(errors)
+	}
+
+	return nil
+}
+
+// SupersededDeviceMultiError is an error wrapping multiple validation errors
+// returned by SupersededDevice.ValidateAll() if the designated constraints
+// aren't met.
+type SupersededDeviceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SupersededDeviceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SupersededDeviceMultiError) AllErrors() []error { return m }
+
+// SupersededDeviceValidationError is the validation error returned by
+// SupersededDevice.Validate if the designated constraints aren't met.
+type SupersededDeviceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SupersededDeviceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SupersededDeviceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SupersededDeviceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SupersededDeviceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SupersededDeviceValidationError) ErrorName() string { return "SupersededDeviceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SupersededDeviceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSupersededDevice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SupersededDeviceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SupersededDeviceValidationError{}
+
 // Validate checks the field values on OfflineRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -252,3 +517,586 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = OfflineRequestValidationError{}
+
+// Validate checks the field values on BatchOfflineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchOfflineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchOfflineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchOfflineRequestMultiError, or nil if none found.
+func (m *BatchOfflineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchOfflineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDevices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOfflineRequestValidationError{
+						field:  fmt.Sprintf("Devices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOfflineRequestValidationError{
+						field:  fmt.Sprintf("Devices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOfflineRequestValidationError{
+					field:  fmt.Sprintf("Devices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchOfflineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchOfflineRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchOfflineRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchOfflineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchOfflineRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchOfflineRequestMultiError) AllErrors() []error { return m }
+
+// BatchOfflineRequestValidationError is the validation error returned by
+// BatchOfflineRequest.Validate if the designated constraints aren't met.
+type BatchOfflineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchOfflineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchOfflineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchOfflineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchOfflineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchOfflineRequestValidationError) ErrorName() string {
+	return "BatchOfflineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchOfflineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchOfflineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchOfflineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchOfflineRequestValidationError{}
+
+// Validate checks the field values on HeartbeatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HeartbeatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HeartbeatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HeartbeatRequestMultiError, or nil if none found.
+func (m *HeartbeatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HeartbeatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for DeviceId
+
+	if len(errors) > 0 {
+		return HeartbeatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HeartbeatRequestMultiError is an error wrapping multiple validation errors
+// returned by HeartbeatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HeartbeatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HeartbeatRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HeartbeatRequestMultiError) AllErrors() []error { return m }
+
+// HeartbeatRequestValidationError is the validation error returned by
+// HeartbeatRequest.Validate if the designated constraints aren't met.
+type HeartbeatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HeartbeatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HeartbeatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HeartbeatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HeartbeatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HeartbeatRequestValidationError) ErrorName() string { return "HeartbeatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HeartbeatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHeartbeatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HeartbeatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HeartbeatRequestValidationError{}
+
+// Validate checks the field values on ListOnlineDevicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOnlineDevicesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOnlineDevicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOnlineDevicesRequestMultiError, or nil if none found.
+func (m *ListOnlineDevicesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOnlineDevicesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListOnlineDevicesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOnlineDevicesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListOnlineDevicesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListOnlineDevicesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOnlineDevicesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOnlineDevicesRequestMultiError) AllErrors() []error { return m }
+
+// ListOnlineDevicesRequestValidationError is the validation error returned by
+// ListOnlineDevicesRequest.Validate if the designated constraints aren't met.
+type ListOnlineDevicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOnlineDevicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOnlineDevicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOnlineDevicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOnlineDevicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOnlineDevicesRequestValidationError) ErrorName() string {
+	return "ListOnlineDevicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOnlineDevicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOnlineDevicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOnlineDevicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOnlineDevicesRequestValidationError{}
+
+// Validate checks the field values on ListOnlineDevicesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOnlineDevicesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOnlineDevicesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOnlineDevicesReplyMultiError, or nil if none found.
+func (m *ListOnlineDevicesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOnlineDevicesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDevices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOnlineDevicesReplyValidationError{
+						field:  fmt.Sprintf("Devices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOnlineDevicesReplyValidationError{
+						field:  fmt.Sprintf("Devices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOnlineDevicesReplyValidationError{
+					field:  fmt.Sprintf("Devices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListOnlineDevicesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOnlineDevicesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListOnlineDevicesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListOnlineDevicesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOnlineDevicesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOnlineDevicesReplyMultiError) AllErrors() []error { return m }
+
+// ListOnlineDevicesReplyValidationError is the validation error returned by
+// ListOnlineDevicesReply.Validate if the designated constraints aren't met.
+type ListOnlineDevicesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOnlineDevicesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOnlineDevicesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOnlineDevicesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOnlineDevicesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOnlineDevicesReplyValidationError) ErrorName() string {
+	return "ListOnlineDevicesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOnlineDevicesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOnlineDevicesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOnlineDevicesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOnlineDevicesReplyValidationError{}
+
+// Validate checks the field values on OnlineDevice with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OnlineDevice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OnlineDevice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OnlineDeviceMultiError, or
+// nil if none found.
+func (m *OnlineDevice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OnlineDevice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeviceId
+
+	// no validation rules for UserId
+
+	// no validation rules for ConnAddr
+
+	if len(errors) > 0 {
+		return OnlineDeviceMultiError(errors)
+	}
+
+	return nil
+}
+
+// OnlineDeviceMultiError is an error wrapping multiple validation errors
+// returned by OnlineDevice.ValidateAll() if the designated constraints aren't met.
+type OnlineDeviceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OnlineDeviceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OnlineDeviceMultiError) AllErrors() []error { return m }
+
+// OnlineDeviceValidationError is the validation error returned by
+// OnlineDevice.Validate if the designated constraints aren't met.
+type OnlineDeviceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OnlineDeviceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OnlineDeviceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OnlineDeviceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OnlineDeviceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OnlineDeviceValidationError) ErrorName() string { return "OnlineDeviceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OnlineDeviceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOnlineDevice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OnlineDeviceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OnlineDeviceValidationError{}
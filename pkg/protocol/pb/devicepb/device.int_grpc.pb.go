@@ -20,18 +20,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DeviceIntService_ConnSignIn_FullMethodName = "/device.DeviceIntService/ConnSignIn"
-	DeviceIntService_Offline_FullMethodName    = "/device.DeviceIntService/Offline"
+	DeviceIntService_ConnSignIn_FullMethodName        = "/device.DeviceIntService/ConnSignIn"
+	DeviceIntService_Offline_FullMethodName           = "/device.DeviceIntService/Offline"
+	DeviceIntService_Heartbeat_FullMethodName         = "/device.DeviceIntService/Heartbeat"
+	DeviceIntService_ListOnlineDevices_FullMethodName = "/device.DeviceIntService/ListOnlineDevices"
+	DeviceIntService_BatchOffline_FullMethodName      = "/device.DeviceIntService/BatchOffline"
 )
 
 // DeviceIntServiceClient is the client API for DeviceIntService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DeviceIntServiceClient interface {
-	// 登录
-	ConnSignIn(ctx context.Context, in *ConnSignInRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 登录，按多端登录策略返回需要被踢下线的设备
+	ConnSignIn(ctx context.Context, in *ConnSignInRequest, opts ...grpc.CallOption) (*ConnSignInReply, error)
 	// 设备离线
 	Offline(ctx context.Context, in *OfflineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 心跳，续期设备在线状态
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 批量查询用户的在线设备及其所在的 connect 节点
+	ListOnlineDevices(ctx context.Context, in *ListOnlineDevicesRequest, opts ...grpc.CallOption) (*ListOnlineDevicesReply, error)
+	// 批量设备离线，connect 节点停机排空时调用
+	BatchOffline(ctx context.Context, in *BatchOfflineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type deviceIntServiceClient struct {
@@ -42,9 +51,9 @@ func NewDeviceIntServiceClient(cc grpc.ClientConnInterface) DeviceIntServiceClie
 	return &deviceIntServiceClient{cc}
 }
 
-func (c *deviceIntServiceClient) ConnSignIn(ctx context.Context, in *ConnSignInRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *deviceIntServiceClient) ConnSignIn(ctx context.Context, in *ConnSignInRequest, opts ...grpc.CallOption) (*ConnSignInReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(ConnSignInReply)
 	err := c.cc.Invoke(ctx, DeviceIntService_ConnSignIn_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -62,14 +71,50 @@ func (c *deviceIntServiceClient) Offline(ctx context.Context, in *OfflineRequest
 	return out, nil
 }
 
+func (c *deviceIntServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DeviceIntService_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceIntServiceClient) ListOnlineDevices(ctx context.Context, in *ListOnlineDevicesRequest, opts ...grpc.CallOption) (*ListOnlineDevicesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOnlineDevicesReply)
+	err := c.cc.Invoke(ctx, DeviceIntService_ListOnlineDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceIntServiceClient) BatchOffline(ctx context.Context, in *BatchOfflineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DeviceIntService_BatchOffline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceIntServiceServer is the server API for DeviceIntService service.
 // All implementations must embed UnimplementedDeviceIntServiceServer
 // for forward compatibility.
 type DeviceIntServiceServer interface {
-	// 登录
-	ConnSignIn(context.Context, *ConnSignInRequest) (*emptypb.Empty, error)
+	// 登录，按多端登录策略返回需要被踢下线的设备
+	ConnSignIn(context.Context, *ConnSignInRequest) (*ConnSignInReply, error)
 	// 设备离线
 	Offline(context.Context, *OfflineRequest) (*emptypb.Empty, error)
+	// 心跳，续期设备在线状态
+	Heartbeat(context.Context, *HeartbeatRequest) (*emptypb.Empty, error)
+	// 批量查询用户的在线设备及其所在的 connect 节点
+	ListOnlineDevices(context.Context, *ListOnlineDevicesRequest) (*ListOnlineDevicesReply, error)
+	// 批量设备离线，connect 节点停机排空时调用
+	BatchOffline(context.Context, *BatchOfflineRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedDeviceIntServiceServer()
 }
 
@@ -80,12 +125,21 @@ type DeviceIntServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedDeviceIntServiceServer struct{}
 
-func (UnimplementedDeviceIntServiceServer) ConnSignIn(context.Context, *ConnSignInRequest) (*emptypb.Empty, error) {
+func (UnimplementedDeviceIntServiceServer) ConnSignIn(context.Context, *ConnSignInRequest) (*ConnSignInReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnSignIn not implemented")
 }
 func (UnimplementedDeviceIntServiceServer) Offline(context.Context, *OfflineRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Offline not implemented")
 }
+func (UnimplementedDeviceIntServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedDeviceIntServiceServer) ListOnlineDevices(context.Context, *ListOnlineDevicesRequest) (*ListOnlineDevicesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOnlineDevices not implemented")
+}
+func (UnimplementedDeviceIntServiceServer) BatchOffline(context.Context, *BatchOfflineRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchOffline not implemented")
+}
 func (UnimplementedDeviceIntServiceServer) mustEmbedUnimplementedDeviceIntServiceServer() {}
 func (UnimplementedDeviceIntServiceServer) testEmbeddedByValue()                          {}
 
@@ -143,6 +197,60 @@ func _DeviceIntService_Offline_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceIntService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceIntServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceIntService_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceIntServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceIntService_ListOnlineDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnlineDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceIntServiceServer).ListOnlineDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceIntService_ListOnlineDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceIntServiceServer).ListOnlineDevices(ctx, req.(*ListOnlineDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceIntService_BatchOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchOfflineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceIntServiceServer).BatchOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceIntService_BatchOffline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceIntServiceServer).BatchOffline(ctx, req.(*BatchOfflineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceIntService_ServiceDesc is the grpc.ServiceDesc for DeviceIntService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -158,6 +266,18 @@ var DeviceIntService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Offline",
 			Handler:    _DeviceIntService_Offline_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _DeviceIntService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "ListOnlineDevices",
+			Handler:    _DeviceIntService_ListOnlineDevices_Handler,
+		},
+		{
+			MethodName: "BatchOffline",
+			Handler:    _DeviceIntService_BatchOffline_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/protocol/proto/device/device.int.proto",
@@ -928,7 +928,7 @@ const file_pkg_protocol_proto_friend_friend_ext_proto_rawDesc = "" +
 	"\x19GetReceivedFriendRequests\x12(.friend.GetReceivedFriendRequestsRequest\x1a).friend.GetReceivedFriendRequestsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/friend/requests/received\x12\x8a\x01\n" +
 	"\x15GetSentFriendRequests\x12$.friend.GetSentFriendRequestsRequest\x1a%.friend.GetSentFriendRequestsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/friend/requests/sent\x12\x8e\x01\n" +
 	"\x13HandleFriendRequest\x12\".friend.HandleFriendRequestRequest\x1a#.friend.HandleFriendRequestResponse\".\x82\xd3\xe4\x93\x02(:\x01*\x1a#/api/v1/friend/request/{request_id}\x12i\n" +
-	"\rGetFriendList\x12\x1c.friend.GetFriendListRequest\x1a\x1d.friend.GetFriendListResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/friend/listB\x1aZ\x18pkg/protocol/pb/friendpbb\x06proto3"
+	"\rGetFriendList\x12\x1c.friend.GetFriendListRequest\x1a\x1d.friend.GetFriendListResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/friend/listB$Z\"im-server/pkg/protocol/pb/friendpbb\x06proto3"
 
 var (
 	file_pkg_protocol_proto_friend_friend_ext_proto_rawDescOnce sync.Once
@@ -0,0 +1,1645 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v3.21.12
+// source: pkg/protocol/proto/group/group.ext.proto
+
+package grouppb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 成员类型
+type MemberType int32
+
+const (
+	MemberType_MEMBER_TYPE_UNSPECIFIED MemberType = 0 // 未指定
+	MemberType_MEMBER_TYPE_ADMIN       MemberType = 1 // 管理员
+	MemberType_MEMBER_TYPE_MEMBER      MemberType = 2 // 普通成员
+	MemberType_MEMBER_TYPE_OWNER       MemberType = 3 // 群主
+)
+
+// Enum value maps for MemberType.
+var (
+	MemberType_name = map[int32]string{
+		0: "MEMBER_TYPE_UNSPECIFIED",
+		1: "MEMBER_TYPE_ADMIN",
+		2: "MEMBER_TYPE_MEMBER",
+		3: "MEMBER_TYPE_OWNER",
+	}
+	MemberType_value = map[string]int32{
+		"MEMBER_TYPE_UNSPECIFIED": 0,
+		"MEMBER_TYPE_ADMIN":       1,
+		"MEMBER_TYPE_MEMBER":      2,
+		"MEMBER_TYPE_OWNER":       3,
+	}
+)
+
+func (x MemberType) Enum() *MemberType {
+	p := new(MemberType)
+	*p = x
+	return p
+}
+
+func (x MemberType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_protocol_proto_group_group_ext_proto_enumTypes[0].Descriptor()
+}
+
+func (MemberType) Type() protoreflect.EnumType {
+	return &file_pkg_protocol_proto_group_group_ext_proto_enumTypes[0]
+}
+
+func (x MemberType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberType.Descriptor instead.
+func (MemberType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{0}
+}
+
+// 创建群组请求
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                    // 群组名称
+	AvatarUrl     string                 `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`         // 群组头像
+	Introduction  string                 `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`                    // 群组简介
+	MemberIds     []uint64               `protobuf:"varint,4,rep,packed,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"` // 初始成员（须为创建者好友）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetIntroduction() string {
+	if x != nil {
+		return x.Introduction
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetMemberIds() []uint64 {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+// 创建群组响应
+type CreateGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群组ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupReply) Reset() {
+	*x = CreateGroupReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupReply) ProtoMessage() {}
+
+func (x *CreateGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupReply.ProtoReflect.Descriptor instead.
+func (*CreateGroupReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateGroupReply) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// 邀请好友入群请求
+type InviteGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`        // 群组ID
+	UserIds       []uint64               `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // 被邀请的好友ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteGroupMembersRequest) Reset() {
+	*x = InviteGroupMembersRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteGroupMembersRequest) ProtoMessage() {}
+
+func (x *InviteGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*InviteGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InviteGroupMembersRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *InviteGroupMembersRequest) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// 邀请好友入群响应
+type InviteGroupMembersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddedUserIds  []uint64               `protobuf:"varint,1,rep,packed,name=added_user_ids,json=addedUserIds,proto3" json:"added_user_ids,omitempty"` // 实际新加入的用户（已在群内的会被忽略）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteGroupMembersReply) Reset() {
+	*x = InviteGroupMembersReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteGroupMembersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteGroupMembersReply) ProtoMessage() {}
+
+func (x *InviteGroupMembersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteGroupMembersReply.ProtoReflect.Descriptor instead.
+func (*InviteGroupMembersReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InviteGroupMembersReply) GetAddedUserIds() []uint64 {
+	if x != nil {
+		return x.AddedUserIds
+	}
+	return nil
+}
+
+// 退出群组请求
+type LeaveGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群组ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGroupRequest) Reset() {
+	*x = LeaveGroupRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupRequest) ProtoMessage() {}
+
+func (x *LeaveGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGroupRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LeaveGroupRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// 退出群组响应
+type LeaveGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGroupReply) Reset() {
+	*x = LeaveGroupReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupReply) ProtoMessage() {}
+
+func (x *LeaveGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupReply.ProtoReflect.Descriptor instead.
+func (*LeaveGroupReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{5}
+}
+
+// 解散群组请求
+type DissolveGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群组ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DissolveGroupRequest) Reset() {
+	*x = DissolveGroupRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DissolveGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DissolveGroupRequest) ProtoMessage() {}
+
+func (x *DissolveGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DissolveGroupRequest.ProtoReflect.Descriptor instead.
+func (*DissolveGroupRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DissolveGroupRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// 解散群组响应
+type DissolveGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DissolveGroupReply) Reset() {
+	*x = DissolveGroupReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DissolveGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DissolveGroupReply) ProtoMessage() {}
+
+func (x *DissolveGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DissolveGroupReply.ProtoReflect.Descriptor instead.
+func (*DissolveGroupReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{7}
+}
+
+// 移出群成员请求
+type KickGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群组ID
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // 被移出的用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickGroupMemberRequest) Reset() {
+	*x = KickGroupMemberRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickGroupMemberRequest) ProtoMessage() {}
+
+func (x *KickGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*KickGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KickGroupMemberRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *KickGroupMemberRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 移出群成员响应
+type KickGroupMemberReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickGroupMemberReply) Reset() {
+	*x = KickGroupMemberReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickGroupMemberReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickGroupMemberReply) ProtoMessage() {}
+
+func (x *KickGroupMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickGroupMemberReply.ProtoReflect.Descriptor instead.
+func (*KickGroupMemberReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{9}
+}
+
+// 设置/取消管理员请求
+type SetGroupAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群组ID
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // 目标用户ID
+	IsAdmin       bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"` // true 设为管理员，false 降为普通成员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupAdminRequest) Reset() {
+	*x = SetGroupAdminRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupAdminRequest) ProtoMessage() {}
+
+func (x *SetGroupAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupAdminRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupAdminRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetGroupAdminRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SetGroupAdminRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetGroupAdminRequest) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+// 设置/取消管理员响应
+type SetGroupAdminReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberType    MemberType             `protobuf:"varint,1,opt,name=member_type,json=memberType,proto3,enum=group.MemberType" json:"member_type,omitempty"` // 目标用户当前的成员类型
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupAdminReply) Reset() {
+	*x = SetGroupAdminReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupAdminReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupAdminReply) ProtoMessage() {}
+
+func (x *SetGroupAdminReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupAdminReply.ProtoReflect.Descriptor instead.
+func (*SetGroupAdminReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetGroupAdminReply) GetMemberType() MemberType {
+	if x != nil {
+		return x.MemberType
+	}
+	return MemberType_MEMBER_TYPE_UNSPECIFIED
+}
+
+// 开启全员禁言请求
+type MuteGroupRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GroupId         uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                         // 群组ID
+	DurationSeconds int64                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 禁言时长（秒），0 表示直到手动解除
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MuteGroupRequest) Reset() {
+	*x = MuteGroupRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteGroupRequest) ProtoMessage() {}
+
+func (x *MuteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteGroupRequest.ProtoReflect.Descriptor instead.
+func (*MuteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MuteGroupRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *MuteGroupRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// 开启全员禁言响应
+type MuteGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MuteUntil     int64                  `protobuf:"varint,1,opt,name=mute_until,json=muteUntil,proto3" json:"mute_until,omitempty"` // 禁言截止时间 (Unix 时间戳)，0 表示直到手动解除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteGroupReply) Reset() {
+	*x = MuteGroupReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteGroupReply) ProtoMessage() {}
+
+func (x *MuteGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteGroupReply.ProtoReflect.Descriptor instead.
+func (*MuteGroupReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MuteGroupReply) GetMuteUntil() int64 {
+	if x != nil {
+		return x.MuteUntil
+	}
+	return 0
+}
+
+// 解除全员禁言请求
+type UnmuteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群组ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteGroupRequest) Reset() {
+	*x = UnmuteGroupRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteGroupRequest) ProtoMessage() {}
+
+func (x *UnmuteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteGroupRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UnmuteGroupRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// 解除全员禁言响应
+type UnmuteGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteGroupReply) Reset() {
+	*x = UnmuteGroupReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteGroupReply) ProtoMessage() {}
+
+func (x *UnmuteGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteGroupReply.ProtoReflect.Descriptor instead.
+func (*UnmuteGroupReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{15}
+}
+
+// 禁言群成员请求
+type MuteGroupMemberRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GroupId         uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                         // 群组ID
+	UserId          uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                            // 被禁言的用户ID
+	DurationSeconds int64                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 禁言时长（秒），0 表示直到手动解除
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MuteGroupMemberRequest) Reset() {
+	*x = MuteGroupMemberRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteGroupMemberRequest) ProtoMessage() {}
+
+func (x *MuteGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*MuteGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MuteGroupMemberRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *MuteGroupMemberRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MuteGroupMemberRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// 禁言群成员响应
+type MuteGroupMemberReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MuteUntil     int64                  `protobuf:"varint,1,opt,name=mute_until,json=muteUntil,proto3" json:"mute_until,omitempty"` // 禁言截止时间 (Unix 时间戳)，0 表示直到手动解除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteGroupMemberReply) Reset() {
+	*x = MuteGroupMemberReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteGroupMemberReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteGroupMemberReply) ProtoMessage() {}
+
+func (x *MuteGroupMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteGroupMemberReply.ProtoReflect.Descriptor instead.
+func (*MuteGroupMemberReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MuteGroupMemberReply) GetMuteUntil() int64 {
+	if x != nil {
+		return x.MuteUntil
+	}
+	return 0
+}
+
+// 解除群成员禁言请求
+type UnmuteGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群组ID
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // 被解除禁言的用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteGroupMemberRequest) Reset() {
+	*x = UnmuteGroupMemberRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteGroupMemberRequest) ProtoMessage() {}
+
+func (x *UnmuteGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnmuteGroupMemberRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *UnmuteGroupMemberRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 解除群成员禁言响应
+type UnmuteGroupMemberReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteGroupMemberReply) Reset() {
+	*x = UnmuteGroupMemberReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteGroupMemberReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteGroupMemberReply) ProtoMessage() {}
+
+func (x *UnmuteGroupMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteGroupMemberReply.ProtoReflect.Descriptor instead.
+func (*UnmuteGroupMemberReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{19}
+}
+
+// 获取群成员列表请求
+type ListGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群组ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersRequest) Reset() {
+	*x = ListGroupMembersRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersRequest) ProtoMessage() {}
+
+func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListGroupMembersRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// 获取群成员列表响应
+type ListGroupMembersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*GroupMemberInfo     `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // 成员列表（群主、管理员在前）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersReply) Reset() {
+	*x = ListGroupMembersReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersReply) ProtoMessage() {}
+
+func (x *ListGroupMembersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersReply.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListGroupMembersReply) GetMembers() []*GroupMemberInfo {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// 获取我加入的群组请求
+type ListMyGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyGroupsRequest) Reset() {
+	*x = ListMyGroupsRequest{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyGroupsRequest) ProtoMessage() {}
+
+func (x *ListMyGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{22}
+}
+
+// 获取我加入的群组响应
+type ListMyGroupsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*GroupInfo           `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // 群组列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyGroupsReply) Reset() {
+	*x = ListMyGroupsReply{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyGroupsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyGroupsReply) ProtoMessage() {}
+
+func (x *ListMyGroupsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyGroupsReply.ProtoReflect.Descriptor instead.
+func (*ListMyGroupsReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListMyGroupsReply) GetGroups() []*GroupInfo {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// 群组信息
+type GroupInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                                // 群组ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                      // 群组名称
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`                           // 群组头像
+	Introduction  string                 `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction,omitempty"`                                      // 群组简介
+	UserNum       int32                  `protobuf:"varint,5,opt,name=user_num,json=userNum,proto3" json:"user_num,omitempty"`                                // 群组人数
+	MemberType    MemberType             `protobuf:"varint,6,opt,name=member_type,json=memberType,proto3,enum=group.MemberType" json:"member_type,omitempty"` // 当前用户在群内的成员类型
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                          // 创建时间 (Unix 时间戳)
+	MuteAll       bool                   `protobuf:"varint,8,opt,name=mute_all,json=muteAll,proto3" json:"mute_all,omitempty"`                                // 是否处于全员禁言
+	MuteAllUntil  int64                  `protobuf:"varint,9,opt,name=mute_all_until,json=muteAllUntil,proto3" json:"mute_all_until,omitempty"`               // 全员禁言截止时间 (Unix 时间戳)，0 表示直到手动解除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupInfo) Reset() {
+	*x = GroupInfo{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInfo) ProtoMessage() {}
+
+func (x *GroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInfo.ProtoReflect.Descriptor instead.
+func (*GroupInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GroupInfo) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupInfo) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *GroupInfo) GetIntroduction() string {
+	if x != nil {
+		return x.Introduction
+	}
+	return ""
+}
+
+func (x *GroupInfo) GetUserNum() int32 {
+	if x != nil {
+		return x.UserNum
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetMemberType() MemberType {
+	if x != nil {
+		return x.MemberType
+	}
+	return MemberType_MEMBER_TYPE_UNSPECIFIED
+}
+
+func (x *GroupInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetMuteAll() bool {
+	if x != nil {
+		return x.MuteAll
+	}
+	return false
+}
+
+func (x *GroupInfo) GetMuteAllUntil() int64 {
+	if x != nil {
+		return x.MuteAllUntil
+	}
+	return 0
+}
+
+// 群成员信息
+type GroupMemberInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                   // 用户ID
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`                                              // 用户名
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`                                              // 昵称
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`                           // 头像
+	MemberType    MemberType             `protobuf:"varint,5,opt,name=member_type,json=memberType,proto3,enum=group.MemberType" json:"member_type,omitempty"` // 成员类型
+	Remarks       string                 `protobuf:"bytes,6,opt,name=remarks,proto3" json:"remarks,omitempty"`                                                // 群内备注
+	JoinedAt      int64                  `protobuf:"varint,7,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`                             // 入群时间 (Unix 时间戳)
+	Muted         bool                   `protobuf:"varint,8,opt,name=muted,proto3" json:"muted,omitempty"`                                                   // 是否处于禁言
+	MutedUntil    int64                  `protobuf:"varint,9,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`                       // 禁言截止时间 (Unix 时间戳)，0 表示直到手动解除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMemberInfo) Reset() {
+	*x = GroupMemberInfo{}
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMemberInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberInfo) ProtoMessage() {}
+
+func (x *GroupMemberInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_group_group_ext_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberInfo.ProtoReflect.Descriptor instead.
+func (*GroupMemberInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GroupMemberInfo) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GroupMemberInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GroupMemberInfo) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *GroupMemberInfo) GetMemberType() MemberType {
+	if x != nil {
+		return x.MemberType
+	}
+	return MemberType_MEMBER_TYPE_UNSPECIFIED
+}
+
+func (x *GroupMemberInfo) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+func (x *GroupMemberInfo) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *GroupMemberInfo) GetMutedUntil() int64 {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return 0
+}
+
+var File_pkg_protocol_proto_group_group_ext_proto protoreflect.FileDescriptor
+
+const file_pkg_protocol_proto_group_group_ext_proto_rawDesc = "" +
+	"\n" +
+	"(pkg/protocol/proto/group/group.ext.proto\x12\x05group\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x17validate/validate.proto\"\xb9\x01\n" +
+	"\x12CreateGroupRequest\x12 \n" +
+	"\x04name\x18\x01 \x01(\tB\f\xe0A\x02\xfaB\x06r\x04\x10\x01\x182R\x04name\x12'\n" +
+	"\n" +
+	"avatar_url\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\tavatarUrl\x12,\n" +
+	"\fintroduction\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\fintroduction\x12*\n" +
+	"\n" +
+	"member_ids\x18\x04 \x03(\x04B\v\xfaB\b\x92\x01\x05\x10\xf4\x03\x18\x01R\tmemberIds\"-\n" +
+	"\x10CreateGroupReply\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x04R\agroupId\"l\n" +
+	"\x19InviteGroupMembersRequest\x12%\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\agroupId\x12(\n" +
+	"\buser_ids\x18\x02 \x03(\x04B\r\xfaB\n" +
+	"\x92\x01\a\b\x01\x10\xf4\x03\x18\x01R\auserIds\"?\n" +
+	"\x17InviteGroupMembersReply\x12$\n" +
+	"\x0eadded_user_ids\x18\x01 \x03(\x04R\faddedUserIds\":\n" +
+	"\x11LeaveGroupRequest\x12%\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\agroupId\"\x11\n" +
+	"\x0fLeaveGroupReply\"=\n" +
+	"\x14DissolveGroupRequest\x12%\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\agroupId\"\x14\n" +
+	"\x12DissolveGroupReply\"d\n" +
+	"\x16KickGroupMemberRequest\x12%\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\agroupId\x12#\n" +
+	"\auser_id\x18\x02 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\x06userId\"\x16\n" +
+	"\x14KickGroupMemberReply\"}\n" +
+	"\x14SetGroupAdminRequest\x12%\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\agroupId\x12#\n" +
+	"\auser_id\x18\x02 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\x06userId\x12\x19\n" +
+	"\bis_admin\x18\x03 \x01(\bR\aisAdmin\"H\n" +
+	"\x12SetGroupAdminReply\x122\n" +
+	"\vmember_type\x18\x01 \x01(\x0e2\x11.group.MemberTypeR\n" +
+	"memberType\"r\n" +
+	"\x10MuteGroupRequest\x12%\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\agroupId\x127\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x03B\f\xfaB\t\"\a\x18\x80\x9a\x9e\x01(\x00R\x0fdurationSeconds\"/\n" +
+	"\x0eMuteGroupReply\x12\x1d\n" +
+	"\n" +
+	"mute_until\x18\x01 \x01(\x03R\tmuteUntil\";\n" +
+	"\x12UnmuteGroupRequest\x12%\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\agroupId\"\x12\n" +
+	"\x10UnmuteGroupReply\"\x9d\x01\n" +
+	"\x16MuteGroupMemberRequest\x12%\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\agroupId\x12#\n" +
+	"\auser_id\x18\x02 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\x06userId\x127\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03B\f\xfaB\t\"\a\x18\x80\x9a\x9e\x01(\x00R\x0fdurationSeconds\"5\n" +
+	"\x14MuteGroupMemberReply\x12\x1d\n" +
+	"\n" +
+	"mute_until\x18\x01 \x01(\x03R\tmuteUntil\"f\n" +
+	"\x18UnmuteGroupMemberRequest\x12%\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\agroupId\x12#\n" +
+	"\auser_id\x18\x02 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\x06userId\"\x18\n" +
+	"\x16UnmuteGroupMemberReply\"@\n" +
+	"\x17ListGroupMembersRequest\x12%\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\n" +
+	"\xe0A\x02\xfaB\x042\x02(\x01R\agroupId\"I\n" +
+	"\x15ListGroupMembersReply\x120\n" +
+	"\amembers\x18\x01 \x03(\v2\x16.group.GroupMemberInfoR\amembers\"\x15\n" +
+	"\x13ListMyGroupsRequest\"=\n" +
+	"\x11ListMyGroupsReply\x12(\n" +
+	"\x06groups\x18\x01 \x03(\v2\x10.group.GroupInfoR\x06groups\"\xac\x02\n" +
+	"\tGroupInfo\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x04R\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\"\n" +
+	"\fintroduction\x18\x04 \x01(\tR\fintroduction\x12\x19\n" +
+	"\buser_num\x18\x05 \x01(\x05R\auserNum\x122\n" +
+	"\vmember_type\x18\x06 \x01(\x0e2\x11.group.MemberTypeR\n" +
+	"memberType\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x19\n" +
+	"\bmute_all\x18\b \x01(\bR\amuteAll\x12$\n" +
+	"\x0emute_all_until\x18\t \x01(\x03R\fmuteAllUntil\"\xa3\x02\n" +
+	"\x0fGroupMemberInfo\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x122\n" +
+	"\vmember_type\x18\x05 \x01(\x0e2\x11.group.MemberTypeR\n" +
+	"memberType\x12\x18\n" +
+	"\aremarks\x18\x06 \x01(\tR\aremarks\x12\x1b\n" +
+	"\tjoined_at\x18\a \x01(\x03R\bjoinedAt\x12\x14\n" +
+	"\x05muted\x18\b \x01(\bR\x05muted\x12\x1f\n" +
+	"\vmuted_until\x18\t \x01(\x03R\n" +
+	"mutedUntil*o\n" +
+	"\n" +
+	"MemberType\x12\x1b\n" +
+	"\x17MEMBER_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11MEMBER_TYPE_ADMIN\x10\x01\x12\x16\n" +
+	"\x12MEMBER_TYPE_MEMBER\x10\x02\x12\x15\n" +
+	"\x11MEMBER_TYPE_OWNER\x10\x032\x97\v\n" +
+	"\x0fGroupExtService\x12[\n" +
+	"\vCreateGroup\x12\x19.group.CreateGroupRequest\x1a\x17.group.CreateGroupReply\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/group\x12\x83\x01\n" +
+	"\x12InviteGroupMembers\x12 .group.InviteGroupMembersRequest\x1a\x1e.group.InviteGroupMembersReply\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/group/{group_id}/members\x12i\n" +
+	"\n" +
+	"LeaveGroup\x12\x18.group.LeaveGroupRequest\x1a\x16.group.LeaveGroupReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/group/{group_id}/leave\x12i\n" +
+	"\rDissolveGroup\x12\x1b.group.DissolveGroupRequest\x1a\x19.group.DissolveGroupReply\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/group/{group_id}\x12\x81\x01\n" +
+	"\x0fKickGroupMember\x12\x1d.group.KickGroupMemberRequest\x1a\x1b.group.KickGroupMemberReply\"2\x82\xd3\xe4\x93\x02,**/api/v1/group/{group_id}/members/{user_id}\x12}\n" +
+	"\rSetGroupAdmin\x12\x1b.group.SetGroupAdminRequest\x1a\x19.group.SetGroupAdminReply\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/api/v1/group/{group_id}/admins/{user_id}\x12e\n" +
+	"\tMuteGroup\x12\x17.group.MuteGroupRequest\x1a\x15.group.MuteGroupReply\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/group/{group_id}/mute\x12h\n" +
+	"\vUnmuteGroup\x12\x19.group.UnmuteGroupRequest\x1a\x17.group.UnmuteGroupReply\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/group/{group_id}/mute\x12\x89\x01\n" +
+	"\x0fMuteGroupMember\x12\x1d.group.MuteGroupMemberRequest\x1a\x1b.group.MuteGroupMemberReply\":\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/group/{group_id}/members/{user_id}/mute\x12\x8c\x01\n" +
+	"\x11UnmuteGroupMember\x12\x1f.group.UnmuteGroupMemberRequest\x1a\x1d.group.UnmuteGroupMemberReply\"7\x82\xd3\xe4\x93\x021*//api/v1/group/{group_id}/members/{user_id}/mute\x12z\n" +
+	"\x10ListGroupMembers\x12\x1e.group.ListGroupMembersRequest\x1a\x1c.group.ListGroupMembersReply\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/group/{group_id}/members\x12`\n" +
+	"\fListMyGroups\x12\x1a.group.ListMyGroupsRequest\x1a\x18.group.ListMyGroupsReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/group/listB#Z!im-server/pkg/protocol/pb/grouppbb\x06proto3"
+
+var (
+	file_pkg_protocol_proto_group_group_ext_proto_rawDescOnce sync.Once
+	file_pkg_protocol_proto_group_group_ext_proto_rawDescData []byte
+)
+
+func file_pkg_protocol_proto_group_group_ext_proto_rawDescGZIP() []byte {
+	file_pkg_protocol_proto_group_group_ext_proto_rawDescOnce.Do(func() {
+		file_pkg_protocol_proto_group_group_ext_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_protocol_proto_group_group_ext_proto_rawDesc), len(file_pkg_protocol_proto_group_group_ext_proto_rawDesc)))
+	})
+	return file_pkg_protocol_proto_group_group_ext_proto_rawDescData
+}
+
+var file_pkg_protocol_proto_group_group_ext_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_protocol_proto_group_group_ext_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_pkg_protocol_proto_group_group_ext_proto_goTypes = []any{
+	(MemberType)(0),                   // 0: group.MemberType
+	(*CreateGroupRequest)(nil),        // 1: group.CreateGroupRequest
+	(*CreateGroupReply)(nil),          // 2: group.CreateGroupReply
+	(*InviteGroupMembersRequest)(nil), // 3: group.InviteGroupMembersRequest
+	(*InviteGroupMembersReply)(nil),   // 4: group.InviteGroupMembersReply
+	(*LeaveGroupRequest)(nil),         // 5: group.LeaveGroupRequest
+	(*LeaveGroupReply)(nil),           // 6: group.LeaveGroupReply
+	(*DissolveGroupRequest)(nil),      // 7: group.DissolveGroupRequest
+	(*DissolveGroupReply)(nil),        // 8: group.DissolveGroupReply
+	(*KickGroupMemberRequest)(nil),    // 9: group.KickGroupMemberRequest
+	(*KickGroupMemberReply)(nil),      // 10: group.KickGroupMemberReply
+	(*SetGroupAdminRequest)(nil),      // 11: group.SetGroupAdminRequest
+	(*SetGroupAdminReply)(nil),        // 12: group.SetGroupAdminReply
+	(*MuteGroupRequest)(nil),          // 13: group.MuteGroupRequest
+	(*MuteGroupReply)(nil),            // 14: group.MuteGroupReply
+	(*UnmuteGroupRequest)(nil),        // 15: group.UnmuteGroupRequest
+	(*UnmuteGroupReply)(nil),          // 16: group.UnmuteGroupReply
+	(*MuteGroupMemberRequest)(nil),    // 17: group.MuteGroupMemberRequest
+	(*MuteGroupMemberReply)(nil),      // 18: group.MuteGroupMemberReply
+	(*UnmuteGroupMemberRequest)(nil),  // 19: group.UnmuteGroupMemberRequest
+	(*UnmuteGroupMemberReply)(nil),    // 20: group.UnmuteGroupMemberReply
+	(*ListGroupMembersRequest)(nil),   // 21: group.ListGroupMembersRequest
+	(*ListGroupMembersReply)(nil),     // 22: group.ListGroupMembersReply
+	(*ListMyGroupsRequest)(nil),       // 23: group.ListMyGroupsRequest
+	(*ListMyGroupsReply)(nil),         // 24: group.ListMyGroupsReply
+	(*GroupInfo)(nil),                 // 25: group.GroupInfo
+	(*GroupMemberInfo)(nil),           // 26: group.GroupMemberInfo
+}
+var file_pkg_protocol_proto_group_group_ext_proto_depIdxs = []int32{
+	0,  // 0: group.SetGroupAdminReply.member_type:type_name -> group.MemberType
+	26, // 1: group.ListGroupMembersReply.members:type_name -> group.GroupMemberInfo
+	25, // 2: group.ListMyGroupsReply.groups:type_name -> group.GroupInfo
+	0,  // 3: group.GroupInfo.member_type:type_name -> group.MemberType
+	0,  // 4: group.GroupMemberInfo.member_type:type_name -> group.MemberType
+	1,  // 5: group.GroupExtService.CreateGroup:input_type -> group.CreateGroupRequest
+	3,  // 6: group.GroupExtService.InviteGroupMembers:input_type -> group.InviteGroupMembersRequest
+	5,  // 7: group.GroupExtService.LeaveGroup:input_type -> group.LeaveGroupRequest
+	7,  // 8: group.GroupExtService.DissolveGroup:input_type -> group.DissolveGroupRequest
+	9,  // 9: group.GroupExtService.KickGroupMember:input_type -> group.KickGroupMemberRequest
+	11, // 10: group.GroupExtService.SetGroupAdmin:input_type -> group.SetGroupAdminRequest
+	13, // 11: group.GroupExtService.MuteGroup:input_type -> group.MuteGroupRequest
+	15, // 12: group.GroupExtService.UnmuteGroup:input_type -> group.UnmuteGroupRequest
+	17, // 13: group.GroupExtService.MuteGroupMember:input_type -> group.MuteGroupMemberRequest
+	19, // 14: group.GroupExtService.UnmuteGroupMember:input_type -> group.UnmuteGroupMemberRequest
+	21, // 15: group.GroupExtService.ListGroupMembers:input_type -> group.ListGroupMembersRequest
+	23, // 16: group.GroupExtService.ListMyGroups:input_type -> group.ListMyGroupsRequest
+	2,  // 17: group.GroupExtService.CreateGroup:output_type -> group.CreateGroupReply
+	4,  // 18: group.GroupExtService.InviteGroupMembers:output_type -> group.InviteGroupMembersReply
+	6,  // 19: group.GroupExtService.LeaveGroup:output_type -> group.LeaveGroupReply
+	8,  // 20: group.GroupExtService.DissolveGroup:output_type -> group.DissolveGroupReply
+	10, // 21: group.GroupExtService.KickGroupMember:output_type -> group.KickGroupMemberReply
+	12, // 22: group.GroupExtService.SetGroupAdmin:output_type -> group.SetGroupAdminReply
+	14, // 23: group.GroupExtService.MuteGroup:output_type -> group.MuteGroupReply
+	16, // 24: group.GroupExtService.UnmuteGroup:output_type -> group.UnmuteGroupReply
+	18, // 25: group.GroupExtService.MuteGroupMember:output_type -> group.MuteGroupMemberReply
+	20, // 26: group.GroupExtService.UnmuteGroupMember:output_type -> group.UnmuteGroupMemberReply
+	22, // 27: group.GroupExtService.ListGroupMembers:output_type -> group.ListGroupMembersReply
+	24, // 28: group.GroupExtService.ListMyGroups:output_type -> group.ListMyGroupsReply
+	17, // [17:29] is the sub-list for method output_type
+	5,  // [5:17] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_pkg_protocol_proto_group_group_ext_proto_init() }
+func file_pkg_protocol_proto_group_group_ext_proto_init() {
+	if File_pkg_protocol_proto_group_group_ext_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_proto_group_group_ext_proto_rawDesc), len(file_pkg_protocol_proto_group_group_ext_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_protocol_proto_group_group_ext_proto_goTypes,
+		DependencyIndexes: file_pkg_protocol_proto_group_group_ext_proto_depIdxs,
+		EnumInfos:         file_pkg_protocol_proto_group_group_ext_proto_enumTypes,
+		MessageInfos:      file_pkg_protocol_proto_group_group_ext_proto_msgTypes,
+	}.Build()
+	File_pkg_protocol_proto_group_group_ext_proto = out.File
+	file_pkg_protocol_proto_group_group_ext_proto_goTypes = nil
+	file_pkg_protocol_proto_group_group_ext_proto_depIdxs = nil
+}
@@ -0,0 +1,3060 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/protocol/proto/group/group.ext.proto
+
+package grouppb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGroupRequestMultiError, or nil if none found.
+func (m *CreateGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := CreateGroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAvatarUrl()) > 255 {
+		err := CreateGroupRequestValidationError{
+			field:  "AvatarUrl",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIntroduction()) > 255 {
+		err := CreateGroupRequestValidationError{
+			field:  "Introduction",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMemberIds()) > 500 {
+		err := CreateGroupRequestValidationError{
+			field:  "MemberIds",
+			reason: "value must contain no more than 500 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateGroupRequest_MemberIds_Unique := make(map[uint64]struct{}, len(m.GetMemberIds()))
+
+	for idx, item := range m.GetMemberIds() {
+		_, _ = idx, item
+
+		if _, exists := _CreateGroupRequest_MemberIds_Unique[item]; exists {
+			err := CreateGroupRequestValidationError{
+				field:  fmt.Sprintf("MemberIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateGroupRequest_MemberIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for MemberIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return CreateGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGroupRequestMultiError) AllErrors() []error { return m }
+
+// CreateGroupRequestValidationError is the validation error returned by
+// CreateGroupRequest.Validate if the designated constraints aren't met.
+type CreateGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGroupRequestValidationError) ErrorName() string {
+	return "CreateGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGroupRequestValidationError{}
+
+// Validate checks the field values on CreateGroupReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGroupReplyMultiError, or nil if none found.
+func (m *CreateGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	if len(errors) > 0 {
+		return CreateGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGroupReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateGroupReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGroupReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGroupReplyMultiError) AllErrors() []error { return m }
+
+// CreateGroupReplyValidationError is the validation error returned by
+// CreateGroupReply.Validate if the designated constraints aren't met.
+type CreateGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGroupReplyValidationError) ErrorName() string { return "CreateGroupReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGroupReplyValidationError{}
+
+// Validate checks the field values on InviteGroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteGroupMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteGroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteGroupMembersRequestMultiError, or nil if none found.
+func (m *InviteGroupMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteGroupMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := InviteGroupMembersRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetUserIds()); l < 1 || l > 500 {
+		err := InviteGroupMembersRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_InviteGroupMembersRequest_UserIds_Unique := make(map[uint64]struct{}, len(m.GetUserIds()))
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if _, exists := _InviteGroupMembersRequest_UserIds_Unique[item]; exists {
+			err := InviteGroupMembersRequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_InviteGroupMembersRequest_UserIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for UserIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return InviteGroupMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteGroupMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by InviteGroupMembersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type InviteGroupMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteGroupMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteGroupMembersRequestMultiError) AllErrors() []error { return m }
+
+// InviteGroupMembersRequestValidationError is the validation error returned by
+// InviteGroupMembersRequest.Validate if the designated constraints aren't met.
+type InviteGroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteGroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteGroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteGroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteGroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteGroupMembersRequestValidationError) ErrorName() string {
+	return "InviteGroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteGroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteGroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteGroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteGroupMembersRequestValidationError{}
+
+// Validate checks the field values on InviteGroupMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteGroupMembersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteGroupMembersReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteGroupMembersReplyMultiError, or nil if none found.
+func (m *InviteGroupMembersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteGroupMembersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InviteGroupMembersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteGroupMembersReplyMultiError is an error wrapping multiple validation
+// errors returned by InviteGroupMembersReply.ValidateAll() if the designated
+// constraints aren't met.
+type InviteGroupMembersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteGroupMembersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteGroupMembersReplyMultiError) AllErrors() []error { return m }
+
+// InviteGroupMembersReplyValidationError is the validation error returned by
+// InviteGroupMembersReply.Validate if the designated constraints aren't met.
+type InviteGroupMembersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteGroupMembersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteGroupMembersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteGroupMembersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteGroupMembersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteGroupMembersReplyValidationError) ErrorName() string {
+	return "InviteGroupMembersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteGroupMembersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteGroupMembersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteGroupMembersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteGroupMembersReplyValidationError{}
+
+// Validate checks the field values on LeaveGroupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeaveGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveGroupRequestMultiError, or nil if none found.
+func (m *LeaveGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := LeaveGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LeaveGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by LeaveGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveGroupRequestMultiError) AllErrors() []error { return m }
+
+// LeaveGroupRequestValidationError is the validation error returned by
+// LeaveGroupRequest.Validate if the designated constraints aren't met.
+type LeaveGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveGroupRequestValidationError) ErrorName() string {
+	return "LeaveGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveGroupRequestValidationError{}
+
+// Validate checks the field values on LeaveGroupReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeaveGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveGroupReplyMultiError, or nil if none found.
+func (m *LeaveGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LeaveGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveGroupReplyMultiError is an error wrapping multiple validation errors
+// returned by LeaveGroupReply.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveGroupReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveGroupReplyMultiError) AllErrors() []error { return m }
+
+// LeaveGroupReplyValidationError is the validation error returned by
+// LeaveGroupReply.Validate if the designated constraints aren't met.
+type LeaveGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveGroupReplyValidationError) ErrorName() string { return "LeaveGroupReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeaveGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveGroupReplyValidationError{}
+
+// Validate checks the field values on DissolveGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DissolveGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DissolveGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DissolveGroupRequestMultiError, or nil if none found.
+func (m *DissolveGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DissolveGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := DissolveGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DissolveGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DissolveGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by DissolveGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DissolveGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DissolveGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DissolveGroupRequestMultiError) AllErrors() []error { return m }
+
+// DissolveGroupRequestValidationError is the validation error returned by
+// DissolveGroupRequest.Validate if the designated constraints aren't met.
+type DissolveGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DissolveGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DissolveGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DissolveGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DissolveGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DissolveGroupRequestValidationError) ErrorName() string {
+	return "DissolveGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DissolveGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDissolveGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DissolveGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DissolveGroupRequestValidationError{}
+
+// Validate checks the field values on DissolveGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DissolveGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DissolveGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DissolveGroupReplyMultiError, or nil if none found.
+func (m *DissolveGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DissolveGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DissolveGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DissolveGroupReplyMultiError is an error wrapping multiple validation errors
+// returned by DissolveGroupReply.ValidateAll() if the designated constraints
+// aren't met.
+type DissolveGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DissolveGroupReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DissolveGroupReplyMultiError) AllErrors() []error { return m }
+
+// DissolveGroupReplyValidationError is the validation error returned by
+// DissolveGroupReply.Validate if the designated constraints aren't met.
+type DissolveGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DissolveGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DissolveGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DissolveGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DissolveGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DissolveGroupReplyValidationError) ErrorName() string {
+	return "DissolveGroupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DissolveGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDissolveGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DissolveGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DissolveGroupReplyValidationError{}
+
+// Validate checks the field values on KickGroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KickGroupMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KickGroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KickGroupMemberRequestMultiError, or nil if none found.
+func (m *KickGroupMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KickGroupMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := KickGroupMemberRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() < 1 {
+		err := KickGroupMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return KickGroupMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// KickGroupMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by KickGroupMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type KickGroupMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KickGroupMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KickGroupMemberRequestMultiError) AllErrors() []error { return m }
+
+// KickGroupMemberRequestValidationError is the validation error returned by
+// KickGroupMemberRequest.Validate if the designated constraints aren't met.
+type KickGroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KickGroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KickGroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KickGroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KickGroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KickGroupMemberRequestValidationError) ErrorName() string {
+	return "KickGroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KickGroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKickGroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KickGroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KickGroupMemberRequestValidationError{}
+
+// Validate checks the field values on KickGroupMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KickGroupMemberReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KickGroupMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KickGroupMemberReplyMultiError, or nil if none found.
+func (m *KickGroupMemberReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KickGroupMemberReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return KickGroupMemberReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// KickGroupMemberReplyMultiError is an error wrapping multiple validation
+// errors returned by KickGroupMemberReply.ValidateAll() if the designated
+// constraints aren't met.
+type KickGroupMemberReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KickGroupMemberReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KickGroupMemberReplyMultiError) AllErrors() []error { return m }
+
+// KickGroupMemberReplyValidationError is the validation error returned by
+// KickGroupMemberReply.Validate if the designated constraints aren't met.
+type KickGroupMemberReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KickGroupMemberReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KickGroupMemberReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KickGroupMemberReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KickGroupMemberReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KickGroupMemberReplyValidationError) ErrorName() string {
+	return "KickGroupMemberReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KickGroupMemberReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKickGroupMemberReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KickGroupMemberReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KickGroupMemberReplyValidationError{}
+
+// Validate checks the field values on SetGroupAdminRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetGroupAdminRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetGroupAdminRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetGroupAdminRequestMultiError, or nil if none found.
+func (m *SetGroupAdminRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetGroupAdminRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := SetGroupAdminRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() < 1 {
+		err := SetGroupAdminRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsAdmin
+
+	if len(errors) > 0 {
+		return SetGroupAdminRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetGroupAdminRequestMultiError is an error wrapping multiple validation
+// errors returned by SetGroupAdminRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetGroupAdminRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetGroupAdminRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetGroupAdminRequestMultiError) AllErrors() []error { return m }
+
+// SetGroupAdminRequestValidationError is the validation error returned by
+// SetGroupAdminRequest.Validate if the designated constraints aren't met.
+type SetGroupAdminRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGroupAdminRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetGroupAdminRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetGroupAdminRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetGroupAdminRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGroupAdminRequestValidationError) ErrorName() string {
+	return "SetGroupAdminRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGroupAdminRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGroupAdminRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGroupAdminRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGroupAdminRequestValidationError{}
+
+// Validate checks the field values on SetGroupAdminReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetGroupAdminReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetGroupAdminReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetGroupAdminReplyMultiError, or nil if none found.
+func (m *SetGroupAdminReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetGroupAdminReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberType
+
+	if len(errors) > 0 {
+		return SetGroupAdminReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetGroupAdminReplyMultiError is an error wrapping multiple validation errors
+// returned by SetGroupAdminReply.ValidateAll() if the designated constraints
+// aren't met.
+type SetGroupAdminReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetGroupAdminReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetGroupAdminReplyMultiError) AllErrors() []error { return m }
+
+// SetGroupAdminReplyValidationError is the validation error returned by
+// SetGroupAdminReply.Validate if the designated constraints aren't met.
+type SetGroupAdminReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGroupAdminReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetGroupAdminReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetGroupAdminReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetGroupAdminReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGroupAdminReplyValidationError) ErrorName() string {
+	return "SetGroupAdminReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGroupAdminReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGroupAdminReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGroupAdminReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGroupAdminReplyValidationError{}
+
+// Validate checks the field values on MuteGroupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MuteGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MuteGroupRequestMultiError, or nil if none found.
+func (m *MuteGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := MuteGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDurationSeconds(); val < 0 || val > 2592000 {
+		err := MuteGroupRequestValidationError{
+			field:  "DurationSeconds",
+			reason: "value must be inside range [0, 2592000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MuteGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by MuteGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MuteGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteGroupRequestMultiError) AllErrors() []error { return m }
+
+// MuteGroupRequestValidationError is the validation error returned by
+// MuteGroupRequest.Validate if the designated constraints aren't met.
+type MuteGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteGroupRequestValidationError) ErrorName() string { return "MuteGroupRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MuteGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteGroupRequestValidationError{}
+
+// Validate checks the field values on MuteGroupReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MuteGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteGroupReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MuteGroupReplyMultiError,
+// or nil if none found.
+func (m *MuteGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MuteUntil
+
+	if len(errors) > 0 {
+		return MuteGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteGroupReplyMultiError is an error wrapping multiple validation errors
+// returned by MuteGroupReply.ValidateAll() if the designated constraints
+// aren't met.
+type MuteGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteGroupReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteGroupReplyMultiError) AllErrors() []error { return m }
+
+// MuteGroupReplyValidationError is the validation error returned by
+// MuteGroupReply.Validate if the designated constraints aren't met.
+type MuteGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteGroupReplyValidationError) ErrorName() string { return "MuteGroupReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MuteGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteGroupReplyValidationError{}
+
+// Validate checks the field values on UnmuteGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnmuteGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnmuteGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnmuteGroupRequestMultiError, or nil if none found.
+func (m *UnmuteGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnmuteGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := UnmuteGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnmuteGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnmuteGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by UnmuteGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnmuteGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnmuteGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnmuteGroupRequestMultiError) AllErrors() []error { return m }
+
+// UnmuteGroupRequestValidationError is the validation error returned by
+// UnmuteGroupRequest.Validate if the designated constraints aren't met.
+type UnmuteGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnmuteGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnmuteGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnmuteGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnmuteGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnmuteGroupRequestValidationError) ErrorName() string {
+	return "UnmuteGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnmuteGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnmuteGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnmuteGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnmuteGroupRequestValidationError{}
+
+// Validate checks the field values on UnmuteGroupReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnmuteGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnmuteGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnmuteGroupReplyMultiError, or nil if none found.
+func (m *UnmuteGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnmuteGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnmuteGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnmuteGroupReplyMultiError is an error wrapping multiple validation errors
+// returned by UnmuteGroupReply.ValidateAll() if the designated constraints
+// aren't met.
+type UnmuteGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnmuteGroupReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnmuteGroupReplyMultiError) AllErrors() []error { return m }
+
+// UnmuteGroupReplyValidationError is the validation error returned by
+// UnmuteGroupReply.Validate if the designated constraints aren't met.
+type UnmuteGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnmuteGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnmuteGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnmuteGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnmuteGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnmuteGroupReplyValidationError) ErrorName() string { return "UnmuteGroupReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnmuteGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnmuteGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnmuteGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnmuteGroupReplyValidationError{}
+
+// Validate checks the field values on MuteGroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MuteGroupMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteGroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MuteGroupMemberRequestMultiError, or nil if none found.
+func (m *MuteGroupMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteGroupMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := MuteGroupMemberRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() < 1 {
+		err := MuteGroupMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDurationSeconds(); val < 0 || val > 2592000 {
+		err := MuteGroupMemberRequestValidationError{
+			field:  "DurationSeconds",
+			reason: "value must be inside range [0, 2592000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MuteGroupMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteGroupMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by MuteGroupMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MuteGroupMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteGroupMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteGroupMemberRequestMultiError) AllErrors() []error { return m }
+
+// MuteGroupMemberRequestValidationError is the validation error returned by
+// MuteGroupMemberRequest.Validate if the designated constraints aren't met.
+type MuteGroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteGroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteGroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteGroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteGroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteGroupMemberRequestValidationError) ErrorName() string {
+	return "MuteGroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MuteGroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteGroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteGroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteGroupMemberRequestValidationError{}
+
+// Validate checks the field values on MuteGroupMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MuteGroupMemberReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteGroupMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MuteGroupMemberReplyMultiError, or nil if none found.
+func (m *MuteGroupMemberReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteGroupMemberReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MuteUntil
+
+	if len(errors) > 0 {
+		return MuteGroupMemberReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteGroupMemberReplyMultiError is an error wrapping multiple validation
+// errors returned by MuteGroupMemberReply.ValidateAll() if the designated
+// constraints aren't met.
+type MuteGroupMemberReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteGroupMemberReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteGroupMemberReplyMultiError) AllErrors() []error { return m }
+
+// MuteGroupMemberReplyValidationError is the validation error returned by
+// MuteGroupMemberReply.Validate if the designated constraints aren't met.
+type MuteGroupMemberReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteGroupMemberReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteGroupMemberReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteGroupMemberReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteGroupMemberReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteGroupMemberReplyValidationError) ErrorName() string {
+	return "MuteGroupMemberReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MuteGroupMemberReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteGroupMemberReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteGroupMemberReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteGroupMemberReplyValidationError{}
+
+// Validate checks the field values on UnmuteGroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnmuteGroupMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnmuteGroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnmuteGroupMemberRequestMultiError, or nil if none found.
+func (m *UnmuteGroupMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnmuteGroupMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := UnmuteGroupMemberRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() < 1 {
+		err := UnmuteGroupMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnmuteGroupMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnmuteGroupMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by UnmuteGroupMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnmuteGroupMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnmuteGroupMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnmuteGroupMemberRequestMultiError) AllErrors() []error { return m }
+
+// UnmuteGroupMemberRequestValidationError is the validation error returned by
+// UnmuteGroupMemberRequest.Validate if the designated constraints aren't met.
+type UnmuteGroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnmuteGroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnmuteGroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnmuteGroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnmuteGroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnmuteGroupMemberRequestValidationError) ErrorName() string {
+	return "UnmuteGroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnmuteGroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnmuteGroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnmuteGroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnmuteGroupMemberRequestValidationError{}
+
+// Validate checks the field values on UnmuteGroupMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnmuteGroupMemberReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnmuteGroupMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnmuteGroupMemberReplyMultiError, or nil if none found.
+func (m *UnmuteGroupMemberReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnmuteGroupMemberReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnmuteGroupMemberReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnmuteGroupMemberReplyMultiError is an error wrapping multiple validation
+// errors returned by UnmuteGroupMemberReply.ValidateAll() if the designated
+// constraints aren't met.
+type UnmuteGroupMemberReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnmuteGroupMemberReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnmuteGroupMemberReplyMultiError) AllErrors() []error { return m }
+
+// UnmuteGroupMemberReplyValidationError is the validation error returned by
+// UnmuteGroupMemberReply.Validate if the designated constraints aren't met.
+type UnmuteGroupMemberReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnmuteGroupMemberReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnmuteGroupMemberReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnmuteGroupMemberReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnmuteGroupMemberReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnmuteGroupMemberReplyValidationError) ErrorName() string {
+	return "UnmuteGroupMemberReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnmuteGroupMemberReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnmuteGroupMemberReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnmuteGroupMemberReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnmuteGroupMemberReplyValidationError{}
+
+// Validate checks the field values on ListGroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListGroupMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListGroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListGroupMembersRequestMultiError, or nil if none found.
+func (m *ListGroupMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListGroupMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := ListGroupMembersRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListGroupMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListGroupMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListGroupMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListGroupMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListGroupMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListGroupMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListGroupMembersRequestValidationError is the validation error returned by
+// ListGroupMembersRequest.Validate if the designated constraints aren't met.
+type ListGroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGroupMembersRequestValidationError) ErrorName() string {
+	return "ListGroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGroupMembersRequestValidationError{}
+
+// Validate checks the field values on ListGroupMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListGroupMembersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListGroupMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListGroupMembersReplyMultiError, or nil if none found.
+func (m *ListGroupMembersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListGroupMembersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListGroupMembersReplyValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListGroupMembersReplyValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListGroupMembersReplyValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListGroupMembersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListGroupMembersReplyMultiError is an error wrapping multiple validation
+// errors returned by ListGroupMembersReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListGroupMembersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListGroupMembersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListGroupMembersReplyMultiError) AllErrors() []error { return m }
+
+// ListGroupMembersReplyValidationError is the validation error returned by
+// ListGroupMembersReply.Validate if the designated constraints aren't met.
+type ListGroupMembersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGroupMembersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGroupMembersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGroupMembersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGroupMembersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGroupMembersReplyValidationError) ErrorName() string {
+	return "ListGroupMembersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGroupMembersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGroupMembersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGroupMembersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGroupMembersReplyValidationError{}
+
+// Validate checks the field values on ListMyGroupsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyGroupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyGroupsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyGroupsRequestMultiError, or nil if none found.
+func (m *ListMyGroupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyGroupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListMyGroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyGroupsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMyGroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMyGroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyGroupsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyGroupsRequestMultiError) AllErrors() []error { return m }
+
+// ListMyGroupsRequestValidationError is the validation error returned by
+// ListMyGroupsRequest.Validate if the designated constraints aren't met.
+type ListMyGroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyGroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyGroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyGroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyGroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyGroupsRequestValidationError) ErrorName() string {
+	return "ListMyGroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyGroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyGroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyGroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyGroupsRequestValidationError{}
+
+// Validate checks the field values on ListMyGroupsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListMyGroupsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyGroupsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyGroupsReplyMultiError, or nil if none found.
+func (m *ListMyGroupsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyGroupsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMyGroupsReplyValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMyGroupsReplyValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMyGroupsReplyValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMyGroupsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyGroupsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListMyGroupsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListMyGroupsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyGroupsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyGroupsReplyMultiError) AllErrors() []error { return m }
+
+// ListMyGroupsReplyValidationError is the validation error returned by
+// ListMyGroupsReply.Validate if the designated constraints aren't met.
+type ListMyGroupsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyGroupsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyGroupsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyGroupsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyGroupsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyGroupsReplyValidationError) ErrorName() string {
+	return "ListMyGroupsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyGroupsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyGroupsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyGroupsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyGroupsReplyValidationError{}
+
+// Validate checks the field values on GroupInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GroupInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GroupInfoMultiError, or nil
+// if none found.
+func (m *GroupInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	// no validation rules for Name
+
+	// no validation rules for AvatarUrl
+
+	// no validation rules for Introduction
+
+	// no validation rules for UserNum
+
+	// no validation rules for MemberType
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for MuteAll
+
+	// no validation rules for MuteAllUntil
+
+	if len(errors) > 0 {
+		return GroupInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupInfoMultiError is an error wrapping multiple validation errors returned
+// by GroupInfo.ValidateAll() if the designated constraints aren't met.
+type GroupInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupInfoMultiError) AllErrors() []error { return m }
+
+// GroupInfoValidationError is the validation error returned by
+// GroupInfo.Validate if the designated constraints aren't met.
+type GroupInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupInfoValidationError) ErrorName() string { return "GroupInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupInfoValidationError{}
+
+// Validate checks the field values on GroupMemberInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GroupMemberInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupMemberInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupMemberInfoMultiError, or nil if none found.
+func (m *GroupMemberInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupMemberInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for Nickname
+
+	// no validation rules for AvatarUrl
+
+	// no validation rules for MemberType
+
+	// no validation rules for Remarks
+
+	// no validation rules for JoinedAt
+
+	// no validation rules for Muted
+
+	// no validation rules for MutedUntil
+
+	if len(errors) > 0 {
+		return GroupMemberInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMemberInfoMultiError is an error wrapping multiple validation errors
+// returned by GroupMemberInfo.ValidateAll() if the designated constraints
+// aren't met.
+type GroupMemberInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMemberInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMemberInfoMultiError) AllErrors() []error { return m }
+
+// GroupMemberInfoValidationError is the validation error returned by
+// GroupMemberInfo.Validate if the designated constraints aren't met.
+type GroupMemberInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupMemberInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupMemberInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupMemberInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupMemberInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupMemberInfoValidationError) ErrorName() string { return "GroupMemberInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupMemberInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupMemberInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupMemberInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupMemberInfoValidationError{}
@@ -0,0 +1,567 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: pkg/protocol/proto/group/group.ext.proto
+
+package grouppb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GroupExtService_CreateGroup_FullMethodName        = "/group.GroupExtService/CreateGroup"
+	GroupExtService_InviteGroupMembers_FullMethodName = "/group.GroupExtService/InviteGroupMembers"
+	GroupExtService_LeaveGroup_FullMethodName         = "/group.GroupExtService/LeaveGroup"
+	GroupExtService_DissolveGroup_FullMethodName      = "/group.GroupExtService/DissolveGroup"
+	GroupExtService_KickGroupMember_FullMethodName    = "/group.GroupExtService/KickGroupMember"
+	GroupExtService_SetGroupAdmin_FullMethodName      = "/group.GroupExtService/SetGroupAdmin"
+	GroupExtService_MuteGroup_FullMethodName          = "/group.GroupExtService/MuteGroup"
+	GroupExtService_UnmuteGroup_FullMethodName        = "/group.GroupExtService/UnmuteGroup"
+	GroupExtService_MuteGroupMember_FullMethodName    = "/group.GroupExtService/MuteGroupMember"
+	GroupExtService_UnmuteGroupMember_FullMethodName  = "/group.GroupExtService/UnmuteGroupMember"
+	GroupExtService_ListGroupMembers_FullMethodName   = "/group.GroupExtService/ListGroupMembers"
+	GroupExtService_ListMyGroups_FullMethodName       = "/group.GroupExtService/ListMyGroups"
+)
+
+// GroupExtServiceClient is the client API for GroupExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 群组服务
+type GroupExtServiceClient interface {
+	// 创建群组（创建者成为群主）
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupReply, error)
+	// 邀请好友入群
+	InviteGroupMembers(ctx context.Context, in *InviteGroupMembersRequest, opts ...grpc.CallOption) (*InviteGroupMembersReply, error)
+	// 退出群组（群主需先解散）
+	LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupReply, error)
+	// 解散群组（仅群主）
+	DissolveGroup(ctx context.Context, in *DissolveGroupRequest, opts ...grpc.CallOption) (*DissolveGroupReply, error)
+	// 移出群成员（群主可移出管理员与成员，管理员仅可移出普通成员）
+	KickGroupMember(ctx context.Context, in *KickGroupMemberRequest, opts ...grpc.CallOption) (*KickGroupMemberReply, error)
+	// 设置/取消管理员（仅群主）
+	SetGroupAdmin(ctx context.Context, in *SetGroupAdminRequest, opts ...grpc.CallOption) (*SetGroupAdminReply, error)
+	// 开启全员禁言（群主/管理员），仅约束普通成员
+	MuteGroup(ctx context.Context, in *MuteGroupRequest, opts ...grpc.CallOption) (*MuteGroupReply, error)
+	// 解除全员禁言（群主/管理员）
+	UnmuteGroup(ctx context.Context, in *UnmuteGroupRequest, opts ...grpc.CallOption) (*UnmuteGroupReply, error)
+	// 禁言群成员（群主可禁言管理员与成员，管理员仅可禁言普通成员）
+	MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest, opts ...grpc.CallOption) (*MuteGroupMemberReply, error)
+	// 解除群成员禁言
+	UnmuteGroupMember(ctx context.Context, in *UnmuteGroupMemberRequest, opts ...grpc.CallOption) (*UnmuteGroupMemberReply, error)
+	// 获取群成员列表
+	ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersReply, error)
+	// 获取我加入的群组
+	ListMyGroups(ctx context.Context, in *ListMyGroupsRequest, opts ...grpc.CallOption) (*ListMyGroupsReply, error)
+}
+
+type groupExtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupExtServiceClient(cc grpc.ClientConnInterface) GroupExtServiceClient {
+	return &groupExtServiceClient{cc}
+}
+
+func (c *groupExtServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupReply)
+	err := c.cc.Invoke(ctx, GroupExtService_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) InviteGroupMembers(ctx context.Context, in *InviteGroupMembersRequest, opts ...grpc.CallOption) (*InviteGroupMembersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteGroupMembersReply)
+	err := c.cc.Invoke(ctx, GroupExtService_InviteGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveGroupReply)
+	err := c.cc.Invoke(ctx, GroupExtService_LeaveGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) DissolveGroup(ctx context.Context, in *DissolveGroupRequest, opts ...grpc.CallOption) (*DissolveGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DissolveGroupReply)
+	err := c.cc.Invoke(ctx, GroupExtService_DissolveGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) KickGroupMember(ctx context.Context, in *KickGroupMemberRequest, opts ...grpc.CallOption) (*KickGroupMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickGroupMemberReply)
+	err := c.cc.Invoke(ctx, GroupExtService_KickGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) SetGroupAdmin(ctx context.Context, in *SetGroupAdminRequest, opts ...grpc.CallOption) (*SetGroupAdminReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupAdminReply)
+	err := c.cc.Invoke(ctx, GroupExtService_SetGroupAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) MuteGroup(ctx context.Context, in *MuteGroupRequest, opts ...grpc.CallOption) (*MuteGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteGroupReply)
+	err := c.cc.Invoke(ctx, GroupExtService_MuteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) UnmuteGroup(ctx context.Context, in *UnmuteGroupRequest, opts ...grpc.CallOption) (*UnmuteGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteGroupReply)
+	err := c.cc.Invoke(ctx, GroupExtService_UnmuteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest, opts ...grpc.CallOption) (*MuteGroupMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteGroupMemberReply)
+	err := c.cc.Invoke(ctx, GroupExtService_MuteGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) UnmuteGroupMember(ctx context.Context, in *UnmuteGroupMemberRequest, opts ...grpc.CallOption) (*UnmuteGroupMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteGroupMemberReply)
+	err := c.cc.Invoke(ctx, GroupExtService_UnmuteGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupMembersReply)
+	err := c.cc.Invoke(ctx, GroupExtService_ListGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtServiceClient) ListMyGroups(ctx context.Context, in *ListMyGroupsRequest, opts ...grpc.CallOption) (*ListMyGroupsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyGroupsReply)
+	err := c.cc.Invoke(ctx, GroupExtService_ListMyGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupExtServiceServer is the server API for GroupExtService service.
+// All implementations must embed UnimplementedGroupExtServiceServer
+// for forward compatibility.
+//
+// 群组服务
+type GroupExtServiceServer interface {
+	// 创建群组（创建者成为群主）
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupReply, error)
+	// 邀请好友入群
+	InviteGroupMembers(context.Context, *InviteGroupMembersRequest) (*InviteGroupMembersReply, error)
+	// 退出群组（群主需先解散）
+	LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupReply, error)
+	// 解散群组（仅群主）
+	DissolveGroup(context.Context, *DissolveGroupRequest) (*DissolveGroupReply, error)
+	// 移出群成员（群主可移出管理员与成员，管理员仅可移出普通成员）
+	KickGroupMember(context.Context, *KickGroupMemberRequest) (*KickGroupMemberReply, error)
+	// 设置/取消管理员（仅群主）
+	SetGroupAdmin(context.Context, *SetGroupAdminRequest) (*SetGroupAdminReply, error)
+	// 开启全员禁言（群主/管理员），仅约束普通成员
+	MuteGroup(context.Context, *MuteGroupRequest) (*MuteGroupReply, error)
+	// 解除全员禁言（群主/管理员）
+	UnmuteGroup(context.Context, *UnmuteGroupRequest) (*UnmuteGroupReply, error)
+	// 禁言群成员（群主可禁言管理员与成员，管理员仅可禁言普通成员）
+	MuteGroupMember(context.Context, *MuteGroupMemberRequest) (*MuteGroupMemberReply, error)
+	// 解除群成员禁言
+	UnmuteGroupMember(context.Context, *UnmuteGroupMemberRequest) (*UnmuteGroupMemberReply, error)
+	// 获取群成员列表
+	ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersReply, error)
+	// 获取我加入的群组
+	ListMyGroups(context.Context, *ListMyGroupsRequest) (*ListMyGroupsReply, error)
+	mustEmbedUnimplementedGroupExtServiceServer()
+}
+
+// UnimplementedGroupExtServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGroupExtServiceServer struct{}
+
+func (UnimplementedGroupExtServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedGroupExtServiceServer) InviteGroupMembers(context.Context, *InviteGroupMembersRequest) (*InviteGroupMembersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteGroupMembers not implemented")
+}
+func (UnimplementedGroupExtServiceServer) LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+}
+func (UnimplementedGroupExtServiceServer) DissolveGroup(context.Context, *DissolveGroupRequest) (*DissolveGroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DissolveGroup not implemented")
+}
+func (UnimplementedGroupExtServiceServer) KickGroupMember(context.Context, *KickGroupMemberRequest) (*KickGroupMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickGroupMember not implemented")
+}
+func (UnimplementedGroupExtServiceServer) SetGroupAdmin(context.Context, *SetGroupAdminRequest) (*SetGroupAdminReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupAdmin not implemented")
+}
+func (UnimplementedGroupExtServiceServer) MuteGroup(context.Context, *MuteGroupRequest) (*MuteGroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteGroup not implemented")
+}
+func (UnimplementedGroupExtServiceServer) UnmuteGroup(context.Context, *UnmuteGroupRequest) (*UnmuteGroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteGroup not implemented")
+}
+func (UnimplementedGroupExtServiceServer) MuteGroupMember(context.Context, *MuteGroupMemberRequest) (*MuteGroupMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteGroupMember not implemented")
+}
+func (UnimplementedGroupExtServiceServer) UnmuteGroupMember(context.Context, *UnmuteGroupMemberRequest) (*UnmuteGroupMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteGroupMember not implemented")
+}
+func (UnimplementedGroupExtServiceServer) ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupMembers not implemented")
+}
+func (UnimplementedGroupExtServiceServer) ListMyGroups(context.Context, *ListMyGroupsRequest) (*ListMyGroupsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyGroups not implemented")
+}
+func (UnimplementedGroupExtServiceServer) mustEmbedUnimplementedGroupExtServiceServer() {}
+func (UnimplementedGroupExtServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeGroupExtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupExtServiceServer will
+// result in compilation errors.
+type UnsafeGroupExtServiceServer interface {
+	mustEmbedUnimplementedGroupExtServiceServer()
+}
+
+func RegisterGroupExtServiceServer(s grpc.ServiceRegistrar, srv GroupExtServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGroupExtServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GroupExtService_ServiceDesc, srv)
+}
+
+func _GroupExtService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_InviteGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).InviteGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_InviteGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).InviteGroupMembers(ctx, req.(*InviteGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_LeaveGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).LeaveGroup(ctx, req.(*LeaveGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_DissolveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DissolveGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).DissolveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_DissolveGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).DissolveGroup(ctx, req.(*DissolveGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_KickGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).KickGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_KickGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).KickGroupMember(ctx, req.(*KickGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_SetGroupAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).SetGroupAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_SetGroupAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).SetGroupAdmin(ctx, req.(*SetGroupAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_MuteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).MuteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_MuteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).MuteGroup(ctx, req.(*MuteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_UnmuteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).UnmuteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_UnmuteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).UnmuteGroup(ctx, req.(*UnmuteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_MuteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).MuteGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_MuteGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).MuteGroupMember(ctx, req.(*MuteGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_UnmuteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).UnmuteGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_UnmuteGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).UnmuteGroupMember(ctx, req.(*UnmuteGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_ListGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).ListGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_ListGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).ListGroupMembers(ctx, req.(*ListGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExtService_ListMyGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServiceServer).ListMyGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExtService_ListMyGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServiceServer).ListMyGroups(ctx, req.(*ListMyGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupExtService_ServiceDesc is the grpc.ServiceDesc for GroupExtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupExtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "group.GroupExtService",
+	HandlerType: (*GroupExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _GroupExtService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "InviteGroupMembers",
+			Handler:    _GroupExtService_InviteGroupMembers_Handler,
+		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _GroupExtService_LeaveGroup_Handler,
+		},
+		{
+			MethodName: "DissolveGroup",
+			Handler:    _GroupExtService_DissolveGroup_Handler,
+		},
+		{
+			MethodName: "KickGroupMember",
+			Handler:    _GroupExtService_KickGroupMember_Handler,
+		},
+		{
+			MethodName: "SetGroupAdmin",
+			Handler:    _GroupExtService_SetGroupAdmin_Handler,
+		},
+		{
+			MethodName: "MuteGroup",
+			Handler:    _GroupExtService_MuteGroup_Handler,
+		},
+		{
+			MethodName: "UnmuteGroup",
+			Handler:    _GroupExtService_UnmuteGroup_Handler,
+		},
+		{
+			MethodName: "MuteGroupMember",
+			Handler:    _GroupExtService_MuteGroupMember_Handler,
+		},
+		{
+			MethodName: "UnmuteGroupMember",
+			Handler:    _GroupExtService_UnmuteGroupMember_Handler,
+		},
+		{
+			MethodName: "ListGroupMembers",
+			Handler:    _GroupExtService_ListGroupMembers_Handler,
+		},
+		{
+			MethodName: "ListMyGroups",
+			Handler:    _GroupExtService_ListMyGroups_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/protocol/proto/group/group.ext.proto",
+}
@@ -23,31 +23,1121 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// 发送好友消息请求
+// 系统通知类型
+type SystemNoticeType int32
+
+const (
+	SystemNoticeType_SYSTEM_NOTICE_UNKNOWN             SystemNoticeType = 0
+	SystemNoticeType_SYSTEM_NOTICE_GROUP_MUTE_ALL      SystemNoticeType = 1 // 开启全员禁言
+	SystemNoticeType_SYSTEM_NOTICE_GROUP_UNMUTE_ALL    SystemNoticeType = 2 // 解除全员禁言
+	SystemNoticeType_SYSTEM_NOTICE_GROUP_MEMBER_MUTE   SystemNoticeType = 3 // 成员被禁言
+	SystemNoticeType_SYSTEM_NOTICE_GROUP_MEMBER_UNMUTE SystemNoticeType = 4 // 成员被解除禁言
+)
+
+// Enum value maps for SystemNoticeType.
+var (
+	SystemNoticeType_name = map[int32]string{
+		0: "SYSTEM_NOTICE_UNKNOWN",
+		1: "SYSTEM_NOTICE_GROUP_MUTE_ALL",
+		2: "SYSTEM_NOTICE_GROUP_UNMUTE_ALL",
+		3: "SYSTEM_NOTICE_GROUP_MEMBER_MUTE",
+		4: "SYSTEM_NOTICE_GROUP_MEMBER_UNMUTE",
+	}
+	SystemNoticeType_value = map[string]int32{
+		"SYSTEM_NOTICE_UNKNOWN":             0,
+		"SYSTEM_NOTICE_GROUP_MUTE_ALL":      1,
+		"SYSTEM_NOTICE_GROUP_UNMUTE_ALL":    2,
+		"SYSTEM_NOTICE_GROUP_MEMBER_MUTE":   3,
+		"SYSTEM_NOTICE_GROUP_MEMBER_UNMUTE": 4,
+	}
+)
+
+func (x SystemNoticeType) Enum() *SystemNoticeType {
+	p := new(SystemNoticeType)
+	*p = x
+	return p
+}
+
+func (x SystemNoticeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SystemNoticeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_protocol_proto_message_message_ext_proto_enumTypes[0].Descriptor()
+}
+
+func (SystemNoticeType) Type() protoreflect.EnumType {
+	return &file_pkg_protocol_proto_message_message_ext_proto_enumTypes[0]
+}
+
+func (x SystemNoticeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SystemNoticeType.Descriptor instead.
+func (SystemNoticeType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{0}
+}
+
+// 发送消息请求（recipient_id 与 group_id 二选一）
 type SendMessageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RecipientId   uint64                 `protobuf:"varint,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
-	ClientMsgId   string                 `protobuf:"bytes,2,opt,name=client_msg_id,json=clientMsgId,proto3" json:"client_msg_id,omitempty"`
-	Content       *MessageContent        `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	RecipientId   uint64                 `protobuf:"varint,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"` // 单聊接收方
+	ClientMsgId   string                 `protobuf:"bytes,2,opt,name=client_msg_id,json=clientMsgId,proto3" json:"client_msg_id,omitempty"`
+	Content       *MessageContent        `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	GroupId       uint64                 `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                   // 群聊群组id
+	ReplyToMsgId  string                 `protobuf:"bytes,5,opt,name=reply_to_msg_id,json=replyToMsgId,proto3" json:"reply_to_msg_id,omitempty"` // 引用/回复的消息ID，须属于同一会话
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SendMessageRequest) GetRecipientId() uint64 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *SendMessageRequest) GetClientMsgId() string {
+	if x != nil {
+		return x.ClientMsgId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetContent() *MessageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SendMessageRequest) GetReplyToMsgId() string {
+	if x != nil {
+		return x.ReplyToMsgId
+	}
+	return ""
+}
+
+// 发送消息响应
+type SendMessageReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Seq            int64                  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	ServerTime     int64                  `protobuf:"varint,4,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	ClientMsgId    string                 `protobuf:"bytes,5,opt,name=client_msg_id,json=clientMsgId,proto3" json:"client_msg_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SendMessageReply) Reset() {
+	*x = SendMessageReply{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageReply) ProtoMessage() {}
+
+func (x *SendMessageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageReply.ProtoReflect.Descriptor instead.
+func (*SendMessageReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendMessageReply) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *SendMessageReply) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SendMessageReply) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SendMessageReply) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+func (x *SendMessageReply) GetClientMsgId() string {
+	if x != nil {
+		return x.ClientMsgId
+	}
+	return ""
+}
+
+// 拉取会话历史消息请求
+// before_seq 与 after_seq 均为开区间游标：仅传 after_seq 时按 seq 正序向后追赶，否则从 before_seq（0 表示最新）向前翻页
+type GetConversationMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	BeforeSeq      int64                  `protobuf:"varint,2,opt,name=before_seq,json=beforeSeq,proto3" json:"before_seq,omitempty"` // 拉取 seq 小于该值的消息，0 表示不限
+	AfterSeq       int64                  `protobuf:"varint,3,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`    // 拉取 seq 大于该值的消息，0 表示不限
+	Limit          uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                          // 每页条数，默认 20
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetConversationMessagesRequest) Reset() {
+	*x = GetConversationMessagesRequest{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationMessagesRequest) ProtoMessage() {}
+
+func (x *GetConversationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetConversationMessagesRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *GetConversationMessagesRequest) GetBeforeSeq() int64 {
+	if x != nil {
+		return x.BeforeSeq
+	}
+	return 0
+}
+
+func (x *GetConversationMessagesRequest) GetAfterSeq() int64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+func (x *GetConversationMessagesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 拉取会话历史消息响应
+type GetConversationMessagesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*MessageInfo         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`               // 按 seq 升序排列
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"` // 游标方向上是否还有更多消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationMessagesReply) Reset() {
+	*x = GetConversationMessagesReply{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationMessagesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationMessagesReply) ProtoMessage() {}
+
+func (x *GetConversationMessagesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationMessagesReply.ProtoReflect.Descriptor instead.
+func (*GetConversationMessagesReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetConversationMessagesReply) GetMessages() []*MessageInfo {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetConversationMessagesReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 消息详情
+type MessageInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	SenderId       uint64                 `protobuf:"varint,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId    uint64                 `protobuf:"varint,4,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Seq            int64                  `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	MessageType    int32                  `protobuf:"varint,6,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"` // 1:文本 2:图片 3:音频 4:视频 5:文件 6:位置 7:系统
+	Status         int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`                              // 消息状态
+	Content        *MessageContent        `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 毫秒时间戳
+	ReplyTo        *QuotedMessage         `protobuf:"bytes,10,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`       // 被引用消息的摘要
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MessageInfo) Reset() {
+	*x = MessageInfo{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageInfo) ProtoMessage() {}
+
+func (x *MessageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageInfo.ProtoReflect.Descriptor instead.
+func (*MessageInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MessageInfo) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageInfo) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *MessageInfo) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetRecipientId() uint64 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetMessageType() int32 {
+	if x != nil {
+		return x.MessageType
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetContent() *MessageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *MessageInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetReplyTo() *QuotedMessage {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return nil
+}
+
+// 被引用消息的摘要
+type QuotedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	SenderId      uint64                 `protobuf:"varint,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	MessageType   int32                  `protobuf:"varint,3,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+	Preview       string                 `protobuf:"bytes,4,opt,name=preview,proto3" json:"preview,omitempty"` // 内容摘要，已撤回时为撤回提示
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotedMessage) Reset() {
+	*x = QuotedMessage{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotedMessage) ProtoMessage() {}
+
+func (x *QuotedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotedMessage.ProtoReflect.Descriptor instead.
+func (*QuotedMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QuotedMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *QuotedMessage) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *QuotedMessage) GetMessageType() int32 {
+	if x != nil {
+		return x.MessageType
+	}
+	return 0
+}
+
+func (x *QuotedMessage) GetPreview() string {
+	if x != nil {
+		return x.Preview
+	}
+	return ""
+}
+
+// 获取会话列表请求
+// 首页（游标为空）会额外返回全部置顶会话，之后按 (updated_at, conversation_id) 键集分页
+type ListConversationsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CursorUpdatedAt      int64                  `protobuf:"varint,1,opt,name=cursor_updated_at,json=cursorUpdatedAt,proto3" json:"cursor_updated_at,omitempty"`               // 上一页最后一项的 updated_at（毫秒），与 cursor_conversation_id 同时为空表示首页
+	CursorConversationId string                 `protobuf:"bytes,2,opt,name=cursor_conversation_id,json=cursorConversationId,proto3" json:"cursor_conversation_id,omitempty"` // 上一页最后一项的 conversation_id
+	Limit                uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                                            // 每页条数，默认 20
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListConversationsRequest) Reset() {
+	*x = ListConversationsRequest{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsRequest) ProtoMessage() {}
+
+func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListConversationsRequest) GetCursorUpdatedAt() int64 {
+	if x != nil {
+		return x.CursorUpdatedAt
+	}
+	return 0
+}
+
+func (x *ListConversationsRequest) GetCursorConversationId() string {
+	if x != nil {
+		return x.CursorConversationId
+	}
+	return ""
+}
+
+func (x *ListConversationsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 获取会话列表响应
+type ListConversationsReply struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Conversations            []*ConversationInfo    `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	HasMore                  bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                                                       // 是否还有下一页
+	NextCursorUpdatedAt      int64                  `protobuf:"varint,3,opt,name=next_cursor_updated_at,json=nextCursorUpdatedAt,proto3" json:"next_cursor_updated_at,omitempty"`               // 下一页游标
+	NextCursorConversationId string                 `protobuf:"bytes,4,opt,name=next_cursor_conversation_id,json=nextCursorConversationId,proto3" json:"next_cursor_conversation_id,omitempty"` // 下一页游标
+	PinnedTruncated          bool                   `protobuf:"varint,5,opt,name=pinned_truncated,json=pinnedTruncated,proto3" json:"pinned_truncated,omitempty"`                               // 首页置顶会话超过上限被截断（仅返回最近活跃的部分）
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ListConversationsReply) Reset() {
+	*x = ListConversationsReply{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsReply) ProtoMessage() {}
+
+func (x *ListConversationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsReply.ProtoReflect.Descriptor instead.
+func (*ListConversationsReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListConversationsReply) GetConversations() []*ConversationInfo {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+func (x *ListConversationsReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListConversationsReply) GetNextCursorUpdatedAt() int64 {
+	if x != nil {
+		return x.NextCursorUpdatedAt
+	}
+	return 0
+}
+
+func (x *ListConversationsReply) GetNextCursorConversationId() string {
+	if x != nil {
+		return x.NextCursorConversationId
+	}
+	return ""
+}
+
+func (x *ListConversationsReply) GetPinnedTruncated() bool {
+	if x != nil {
+		return x.PinnedTruncated
+	}
+	return false
+}
+
+// 会话信息
+type ConversationInfo struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId        string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Type                  int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"` // 1:单聊 2:群聊
+	LastMessageId         string                 `protobuf:"bytes,3,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
+	LastSeq               int64                  `protobuf:"varint,4,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`
+	LastMessagePreview    string                 `protobuf:"bytes,5,opt,name=last_message_preview,json=lastMessagePreview,proto3" json:"last_message_preview,omitempty"` // 最后一条消息的摘要
+	UnreadCount           int32                  `protobuf:"varint,6,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	LastReadSeq           int64                  `protobuf:"varint,7,opt,name=last_read_seq,json=lastReadSeq,proto3" json:"last_read_seq,omitempty"`
+	IsPinned              bool                   `protobuf:"varint,8,opt,name=is_pinned,json=isPinned,proto3" json:"is_pinned,omitempty"`
+	IsMuted               bool                   `protobuf:"varint,9,opt,name=is_muted,json=isMuted,proto3" json:"is_muted,omitempty"`
+	UpdatedAt             int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                         // 毫秒时间戳
+	Peer                  *PeerInfo              `protobuf:"bytes,11,opt,name=peer,proto3" json:"peer,omitempty"`                                                                     // 单聊对端资料
+	Group                 *GroupBrief            `protobuf:"bytes,12,opt,name=group,proto3" json:"group,omitempty"`                                                                   // 群聊群组资料
+	MentionCount          int32                  `protobuf:"varint,13,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`                                // 未读 @ 数（免打扰会话同样计数，客户端应照常提醒）
+	FirstUnreadMentionSeq int64                  `protobuf:"varint,14,opt,name=first_unread_mention_seq,json=firstUnreadMentionSeq,proto3" json:"first_unread_mention_seq,omitempty"` // 首条未读 @ 消息的 seq，0 表示无
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ConversationInfo) Reset() {
+	*x = ConversationInfo{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationInfo) ProtoMessage() {}
+
+func (x *ConversationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationInfo.ProtoReflect.Descriptor instead.
+func (*ConversationInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConversationInfo) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ConversationInfo) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetLastMessageId() string {
+	if x != nil {
+		return x.LastMessageId
+	}
+	return ""
+}
+
+func (x *ConversationInfo) GetLastSeq() int64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetLastMessagePreview() string {
+	if x != nil {
+		return x.LastMessagePreview
+	}
+	return ""
+}
+
+func (x *ConversationInfo) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetLastReadSeq() int64 {
+	if x != nil {
+		return x.LastReadSeq
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+func (x *ConversationInfo) GetIsMuted() bool {
+	if x != nil {
+		return x.IsMuted
+	}
+	return false
+}
+
+func (x *ConversationInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetPeer() *PeerInfo {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *ConversationInfo) GetGroup() *GroupBrief {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *ConversationInfo) GetMentionCount() int32 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetFirstUnreadMentionSeq() int64 {
+	if x != nil {
+		return x.FirstUnreadMentionSeq
+	}
+	return 0
+}
+
+// 会话对端的用户资料
+type PeerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerInfo) Reset() {
+	*x = PeerInfo{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerInfo) ProtoMessage() {}
+
+func (x *PeerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerInfo.ProtoReflect.Descriptor instead.
+func (*PeerInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PeerInfo) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+// 标记会话已读请求
+type MarkConversationReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ReadSeq        int64                  `protobuf:"varint,2,opt,name=read_seq,json=readSeq,proto3" json:"read_seq,omitempty"` // 已读到的序列号，0 表示读到最新
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkConversationReadRequest) Reset() {
+	*x = MarkConversationReadRequest{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkConversationReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkConversationReadRequest) ProtoMessage() {}
+
+func (x *MarkConversationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkConversationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkConversationReadRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MarkConversationReadRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *MarkConversationReadRequest) GetReadSeq() int64 {
+	if x != nil {
+		return x.ReadSeq
+	}
+	return 0
+}
+
+// 标记会话已读响应
+type MarkConversationReadReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ReadSeq        int64                  `protobuf:"varint,2,opt,name=read_seq,json=readSeq,proto3" json:"read_seq,omitempty"` // 实际生效的已读序列号
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkConversationReadReply) Reset() {
+	*x = MarkConversationReadReply{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkConversationReadReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkConversationReadReply) ProtoMessage() {}
+
+func (x *MarkConversationReadReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkConversationReadReply.ProtoReflect.Descriptor instead.
+func (*MarkConversationReadReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MarkConversationReadReply) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *MarkConversationReadReply) GetReadSeq() int64 {
+	if x != nil {
+		return x.ReadSeq
+	}
+	return 0
+}
+
+// 撤回消息请求
+type RecallMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecallMessageRequest) Reset() {
+	*x = RecallMessageRequest{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecallMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecallMessageRequest) ProtoMessage() {}
+
+func (x *RecallMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecallMessageRequest.ProtoReflect.Descriptor instead.
+func (*RecallMessageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RecallMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// 撤回消息响应
+type RecallMessageReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Seq            int64                  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecallMessageReply) Reset() {
+	*x = RecallMessageReply{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecallMessageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecallMessageReply) ProtoMessage() {}
+
+func (x *RecallMessageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecallMessageReply.ProtoReflect.Descriptor instead.
+func (*RecallMessageReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RecallMessageReply) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RecallMessageReply) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *RecallMessageReply) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// 离线同步请求：按会话游标，或以一个全局收件箱游标同步
+// cursors 为空时为全局模式：同步 inbox_cursor 之后有更新的会话（最多 500 个，最近更新的优先），包括离线期间新建的会话；
+// has_more 为 true 时以回复中的 cursors 继续拉取，全部拉完后保存首批回复中的 inbox_cursor 供下次全局同步使用
+type SyncMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursors       []*SyncCursor          `protobuf:"bytes,1,rep,name=cursors,proto3" json:"cursors,omitempty"`                             // 各会话客户端已知的最大 seq
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                // 每批消息条数，默认 50
+	InboxCursor   int64                  `protobuf:"varint,3,opt,name=inbox_cursor,json=inboxCursor,proto3" json:"inbox_cursor,omitempty"` // 全局收件箱游标，0 表示从头同步；与 cursors 互斥
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendMessageRequest) Reset() {
-	*x = SendMessageRequest{}
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[0]
+func (x *SyncMessagesRequest) Reset() {
+	*x = SyncMessagesRequest{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMessageRequest) String() string {
+func (x *SyncMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageRequest) ProtoMessage() {}
+func (*SyncMessagesRequest) ProtoMessage() {}
 
-func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[0]
+func (x *SyncMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,59 +1148,125 @@ func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendMessageRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use SyncMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SyncMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SendMessageRequest) GetRecipientId() uint64 {
+func (x *SyncMessagesRequest) GetCursors() []*SyncCursor {
 	if x != nil {
-		return x.RecipientId
+		return x.Cursors
+	}
+	return nil
+}
+
+func (x *SyncMessagesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *SendMessageRequest) GetClientMsgId() string {
+func (x *SyncMessagesRequest) GetInboxCursor() int64 {
 	if x != nil {
-		return x.ClientMsgId
+		return x.InboxCursor
 	}
-	return ""
+	return 0
 }
 
-func (x *SendMessageRequest) GetContent() *MessageContent {
+// 离线同步响应
+type SyncMessagesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*MessageInfo         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`                           // 按请求中会话的顺序排列，会话内按 seq 升序
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`             // 是否还有未同步的消息
+	Cursors       []*SyncCursor          `protobuf:"bytes,3,rep,name=cursors,proto3" json:"cursors,omitempty"`                             // 仍有剩余消息的会话游标，用于拉取下一批
+	InboxCursor   int64                  `protobuf:"varint,4,opt,name=inbox_cursor,json=inboxCursor,proto3" json:"inbox_cursor,omitempty"` // 全局模式下为本次同步的起始时间（毫秒时间戳），作为下次全局同步的游标
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncMessagesReply) Reset() {
+	*x = SyncMessagesReply{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncMessagesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMessagesReply) ProtoMessage() {}
+
+func (x *SyncMessagesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[15]
 	if x != nil {
-		return x.Content
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMessagesReply.ProtoReflect.Descriptor instead.
+func (*SyncMessagesReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SyncMessagesReply) GetMessages() []*MessageInfo {
+	if x != nil {
+		return x.Messages
 	}
 	return nil
 }
 
-// 发送好友消息响应
-type SendMessageReply struct {
+func (x *SyncMessagesReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *SyncMessagesReply) GetCursors() []*SyncCursor {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+func (x *SyncMessagesReply) GetInboxCursor() int64 {
+	if x != nil {
+		return x.InboxCursor
+	}
+	return 0
+}
+
+// 会话同步游标
+type SyncCursor struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	Seq            int64                  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
-	ServerTime     int64                  `protobuf:"varint,4,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
-	ClientMsgId    string                 `protobuf:"bytes,5,opt,name=client_msg_id,json=clientMsgId,proto3" json:"client_msg_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Seq            int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"` // 拉取 seq 大于该值的消息
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SendMessageReply) Reset() {
-	*x = SendMessageReply{}
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[1]
+func (x *SyncCursor) Reset() {
+	*x = SyncCursor{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMessageReply) String() string {
+func (x *SyncCursor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageReply) ProtoMessage() {}
+func (*SyncCursor) ProtoMessage() {}
 
-func (x *SendMessageReply) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[1]
+func (x *SyncCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -121,46 +1277,94 @@ func (x *SendMessageReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageReply.ProtoReflect.Descriptor instead.
-func (*SendMessageReply) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use SyncCursor.ProtoReflect.Descriptor instead.
+func (*SyncCursor) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SendMessageReply) GetMessageId() string {
+func (x *SyncCursor) GetConversationId() string {
 	if x != nil {
-		return x.MessageId
+		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *SendMessageReply) GetConversationId() string {
+func (x *SyncCursor) GetSeq() int64 {
 	if x != nil {
-		return x.ConversationId
+		return x.Seq
 	}
-	return ""
+	return 0
 }
 
-func (x *SendMessageReply) GetSeq() int64 {
+// 会话所属群组的简要资料
+type GroupBrief struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	UserNum       int32                  `protobuf:"varint,4,opt,name=user_num,json=userNum,proto3" json:"user_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupBrief) Reset() {
+	*x = GroupBrief{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupBrief) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupBrief) ProtoMessage() {}
+
+func (x *GroupBrief) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[17]
 	if x != nil {
-		return x.Seq
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SendMessageReply) GetServerTime() int64 {
+// Deprecated: Use GroupBrief.ProtoReflect.Descriptor instead.
+func (*GroupBrief) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GroupBrief) GetGroupId() uint64 {
 	if x != nil {
-		return x.ServerTime
+		return x.GroupId
 	}
 	return 0
 }
 
-func (x *SendMessageReply) GetClientMsgId() string {
+func (x *GroupBrief) GetName() string {
 	if x != nil {
-		return x.ClientMsgId
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupBrief) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
 	}
 	return ""
 }
 
+func (x *GroupBrief) GetUserNum() int32 {
+	if x != nil {
+		return x.UserNum
+	}
+	return 0
+}
+
 // 消息内容
 type MessageContent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -170,14 +1374,17 @@ type MessageContent struct {
 	//	*MessageContent_Image
 	//	*MessageContent_Audio
 	//	*MessageContent_File
+	//	*MessageContent_System
 	Content       isMessageContent_Content `protobuf_oneof:"content"`
+	MentionIds    []uint64                 `protobuf:"varint,10,rep,packed,name=mention_ids,json=mentionIds,proto3" json:"mention_ids,omitempty"` // 被 @ 的用户，仅群聊有效
+	MentionAll    bool                     `protobuf:"varint,11,opt,name=mention_all,json=mentionAll,proto3" json:"mention_all,omitempty"`        // @全体成员，仅群主/管理员可用
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageContent) Reset() {
 	*x = MessageContent{}
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[2]
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -189,7 +1396,7 @@ func (x *MessageContent) String() string {
 func (*MessageContent) ProtoMessage() {}
 
 func (x *MessageContent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[2]
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -202,7 +1409,7 @@ func (x *MessageContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageContent.ProtoReflect.Descriptor instead.
 func (*MessageContent) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{2}
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *MessageContent) GetContent() isMessageContent_Content {
@@ -248,6 +1455,29 @@ func (x *MessageContent) GetFile() *FileContent {
 	return nil
 }
 
+func (x *MessageContent) GetSystem() *SystemContent {
+	if x != nil {
+		if x, ok := x.Content.(*MessageContent_System); ok {
+			return x.System
+		}
+	}
+	return nil
+}
+
+func (x *MessageContent) GetMentionIds() []uint64 {
+	if x != nil {
+		return x.MentionIds
+	}
+	return nil
+}
+
+func (x *MessageContent) GetMentionAll() bool {
+	if x != nil {
+		return x.MentionAll
+	}
+	return false
+}
+
 type isMessageContent_Content interface {
 	isMessageContent_Content()
 }
@@ -268,6 +1498,10 @@ type MessageContent_File struct {
 	File *FileContent `protobuf:"bytes,4,opt,name=file,proto3,oneof"`
 }
 
+type MessageContent_System struct {
+	System *SystemContent `protobuf:"bytes,5,opt,name=system,proto3,oneof"` // 系统消息，仅由服务端产生
+}
+
 func (*MessageContent_Text) isMessageContent_Content() {}
 
 func (*MessageContent_Image) isMessageContent_Content() {}
@@ -276,6 +1510,8 @@ func (*MessageContent_Audio) isMessageContent_Content() {}
 
 func (*MessageContent_File) isMessageContent_Content() {}
 
+func (*MessageContent_System) isMessageContent_Content() {}
+
 // 文本消息
 type TextContent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -286,7 +1522,7 @@ type TextContent struct {
 
 func (x *TextContent) Reset() {
 	*x = TextContent{}
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[3]
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -298,7 +1534,7 @@ func (x *TextContent) String() string {
 func (*TextContent) ProtoMessage() {}
 
 func (x *TextContent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[3]
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +1547,7 @@ func (x *TextContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TextContent.ProtoReflect.Descriptor instead.
 func (*TextContent) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{3}
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TextContent) GetText() string {
@@ -334,7 +1570,7 @@ type ImageContent struct {
 
 func (x *ImageContent) Reset() {
 	*x = ImageContent{}
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[4]
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -346,7 +1582,7 @@ func (x *ImageContent) String() string {
 func (*ImageContent) ProtoMessage() {}
 
 func (x *ImageContent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[4]
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,7 +1595,7 @@ func (x *ImageContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageContent.ProtoReflect.Descriptor instead.
 func (*ImageContent) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{4}
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ImageContent) GetUrl() string {
@@ -402,7 +1638,7 @@ type AudioContent struct {
 
 func (x *AudioContent) Reset() {
 	*x = AudioContent{}
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[5]
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -414,7 +1650,7 @@ func (x *AudioContent) String() string {
 func (*AudioContent) ProtoMessage() {}
 
 func (x *AudioContent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[5]
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +1663,7 @@ func (x *AudioContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AudioContent.ProtoReflect.Descriptor instead.
 func (*AudioContent) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{5}
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AudioContent) GetUrl() string {
@@ -464,7 +1700,7 @@ type FileContent struct {
 
 func (x *FileContent) Reset() {
 	*x = FileContent{}
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[6]
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +1712,7 @@ func (x *FileContent) String() string {
 func (*FileContent) ProtoMessage() {}
 
 func (x *FileContent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[6]
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +1725,7 @@ func (x *FileContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileContent.ProtoReflect.Descriptor instead.
 func (*FileContent) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{6}
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *FileContent) GetUrl() string {
@@ -520,17 +1756,95 @@ func (x *FileContent) GetMimeType() string {
 	return ""
 }
 
+// 系统消息
+type SystemContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          SystemNoticeType       `protobuf:"varint,1,opt,name=type,proto3,enum=message.SystemNoticeType" json:"type,omitempty"`
+	OperatorId    uint64                 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`     // 操作者
+	TargetIds     []uint64               `protobuf:"varint,3,rep,packed,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"` // 被操作的用户
+	Until         int64                  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`                                 // 截止时间（秒级时间戳），0 表示直到手动解除
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`                                    // 展示文案
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemContent) Reset() {
+	*x = SystemContent{}
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemContent) ProtoMessage() {}
+
+func (x *SystemContent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_ext_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemContent.ProtoReflect.Descriptor instead.
+func (*SystemContent) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SystemContent) GetType() SystemNoticeType {
+	if x != nil {
+		return x.Type
+	}
+	return SystemNoticeType_SYSTEM_NOTICE_UNKNOWN
+}
+
+func (x *SystemContent) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *SystemContent) GetTargetIds() []uint64 {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+func (x *SystemContent) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *SystemContent) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
 var File_pkg_protocol_proto_message_message_ext_proto protoreflect.FileDescriptor
 
 const file_pkg_protocol_proto_message_message_ext_proto_rawDesc = "" +
 	"\n" +
-	",pkg/protocol/proto/message/message.ext.proto\x12\amessage\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x17validate/validate.proto\"\xab\x01\n" +
-	"\x12SendMessageRequest\x12-\n" +
-	"\frecipient_id\x18\x01 \x01(\x04B\n" +
-	"\xe0A\x02\xfaB\x042\x02 \x00R\vrecipientId\x12.\n" +
+	",pkg/protocol/proto/message/message.ext.proto\x12\amessage\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x17validate/validate.proto\"\xea\x01\n" +
+	"\x12SendMessageRequest\x12!\n" +
+	"\frecipient_id\x18\x01 \x01(\x04R\vrecipientId\x12.\n" +
 	"\rclient_msg_id\x18\x02 \x01(\tB\n" +
 	"\xe0A\x02\xfaB\x04r\x02\x10\x01R\vclientMsgId\x126\n" +
-	"\acontent\x18\x03 \x01(\v2\x17.message.MessageContentB\x03\xe0A\x02R\acontent\"\xb1\x01\n" +
+	"\acontent\x18\x03 \x01(\v2\x17.message.MessageContentB\x03\xe0A\x02R\acontent\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\x04R\agroupId\x12.\n" +
+	"\x0freply_to_msg_id\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18 R\freplyToMsgId\"\xb1\x01\n" +
 	"\x10SendMessageReply\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12'\n" +
@@ -538,12 +1852,115 @@ const file_pkg_protocol_proto_message_message_ext_proto_rawDesc = "" +
 	"\x03seq\x18\x03 \x01(\x03R\x03seq\x12\x1f\n" +
 	"\vserver_time\x18\x04 \x01(\x03R\n" +
 	"serverTime\x12\"\n" +
-	"\rclient_msg_id\x18\x05 \x01(\tR\vclientMsgId\"\xd1\x01\n" +
+	"\rclient_msg_id\x18\x05 \x01(\tR\vclientMsgId\"\xc4\x01\n" +
+	"\x1eGetConversationMessagesRequest\x125\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tB\f\xe0A\x02\xfaB\x06r\x04\x10\x01\x18 R\x0econversationId\x12&\n" +
+	"\n" +
+	"before_seq\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tbeforeSeq\x12$\n" +
+	"\tafter_seq\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\bafterSeq\x12\x1d\n" +
+	"\x05limit\x18\x04 \x01(\rB\a\xfaB\x04*\x02\x18dR\x05limit\"k\n" +
+	"\x1cGetConversationMessagesReply\x120\n" +
+	"\bmessages\x18\x01 \x03(\v2\x14.message.MessageInfoR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xe7\x02\n" +
+	"\vMessageInfo\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\x04R\bsenderId\x12!\n" +
+	"\frecipient_id\x18\x04 \x01(\x04R\vrecipientId\x12\x10\n" +
+	"\x03seq\x18\x05 \x01(\x03R\x03seq\x12!\n" +
+	"\fmessage_type\x18\x06 \x01(\x05R\vmessageType\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x121\n" +
+	"\acontent\x18\b \x01(\v2\x17.message.MessageContentR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x121\n" +
+	"\breply_to\x18\n" +
+	" \x01(\v2\x16.message.QuotedMessageR\areplyTo\"\x88\x01\n" +
+	"\rQuotedMessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\x04R\bsenderId\x12!\n" +
+	"\fmessage_type\x18\x03 \x01(\x05R\vmessageType\x12\x18\n" +
+	"\apreview\x18\x04 \x01(\tR\apreview\"\xad\x01\n" +
+	"\x18ListConversationsRequest\x123\n" +
+	"\x11cursor_updated_at\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0fcursorUpdatedAt\x12=\n" +
+	"\x16cursor_conversation_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18 R\x14cursorConversationId\x12\x1d\n" +
+	"\x05limit\x18\x03 \x01(\rB\a\xfaB\x04*\x02\x18dR\x05limit\"\x93\x02\n" +
+	"\x16ListConversationsReply\x12?\n" +
+	"\rconversations\x18\x01 \x03(\v2\x19.message.ConversationInfoR\rconversations\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x123\n" +
+	"\x16next_cursor_updated_at\x18\x03 \x01(\x03R\x13nextCursorUpdatedAt\x12=\n" +
+	"\x1bnext_cursor_conversation_id\x18\x04 \x01(\tR\x18nextCursorConversationId\x12)\n" +
+	"\x10pinned_truncated\x18\x05 \x01(\bR\x0fpinnedTruncated\"\x92\x04\n" +
+	"\x10ConversationInfo\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12&\n" +
+	"\x0flast_message_id\x18\x03 \x01(\tR\rlastMessageId\x12\x19\n" +
+	"\blast_seq\x18\x04 \x01(\x03R\alastSeq\x120\n" +
+	"\x14last_message_preview\x18\x05 \x01(\tR\x12lastMessagePreview\x12!\n" +
+	"\funread_count\x18\x06 \x01(\x05R\vunreadCount\x12\"\n" +
+	"\rlast_read_seq\x18\a \x01(\x03R\vlastReadSeq\x12\x1b\n" +
+	"\tis_pinned\x18\b \x01(\bR\bisPinned\x12\x19\n" +
+	"\bis_muted\x18\t \x01(\bR\aisMuted\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12%\n" +
+	"\x04peer\x18\v \x01(\v2\x11.message.PeerInfoR\x04peer\x12)\n" +
+	"\x05group\x18\f \x01(\v2\x13.message.GroupBriefR\x05group\x12#\n" +
+	"\rmention_count\x18\r \x01(\x05R\fmentionCount\x127\n" +
+	"\x18first_unread_mention_seq\x18\x0e \x01(\x03R\x15firstUnreadMentionSeq\"z\n" +
+	"\bPeerInfo\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"x\n" +
+	"\x1bMarkConversationReadRequest\x125\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tB\f\xe0A\x02\xfaB\x06r\x04\x10\x01\x18 R\x0econversationId\x12\"\n" +
+	"\bread_seq\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\areadSeq\"_\n" +
+	"\x19MarkConversationReadReply\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x19\n" +
+	"\bread_seq\x18\x02 \x01(\x03R\areadSeq\"C\n" +
+	"\x14RecallMessageRequest\x12+\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tB\f\xe0A\x02\xfaB\x06r\x04\x10\x01\x18 R\tmessageId\"n\n" +
+	"\x12RecallMessageReply\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x10\n" +
+	"\x03seq\x18\x03 \x01(\x03R\x03seq\"\x9a\x01\n" +
+	"\x13SyncMessagesRequest\x128\n" +
+	"\acursors\x18\x01 \x03(\v2\x13.message.SyncCursorB\t\xfaB\x06\x92\x01\x03\x10\xf4\x03R\acursors\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\rB\a\xfaB\x04*\x02\x18dR\x05limit\x12*\n" +
+	"\finbox_cursor\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vinboxCursor\"\xb2\x01\n" +
+	"\x11SyncMessagesReply\x120\n" +
+	"\bmessages\x18\x01 \x03(\v2\x14.message.MessageInfoR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12-\n" +
+	"\acursors\x18\x03 \x03(\v2\x13.message.SyncCursorR\acursors\x12!\n" +
+	"\finbox_cursor\x18\x04 \x01(\x03R\vinboxCursor\"[\n" +
+	"\n" +
+	"SyncCursor\x122\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x0econversationId\x12\x19\n" +
+	"\x03seq\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x03seq\"u\n" +
+	"\n" +
+	"GroupBrief\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x04R\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x19\n" +
+	"\buser_num\x18\x04 \x01(\x05R\auserNum\"\xd1\x02\n" +
 	"\x0eMessageContent\x12*\n" +
 	"\x04text\x18\x01 \x01(\v2\x14.message.TextContentH\x00R\x04text\x12-\n" +
 	"\x05image\x18\x02 \x01(\v2\x15.message.ImageContentH\x00R\x05image\x12-\n" +
 	"\x05audio\x18\x03 \x01(\v2\x15.message.AudioContentH\x00R\x05audio\x12*\n" +
-	"\x04file\x18\x04 \x01(\v2\x14.message.FileContentH\x00R\x04fileB\t\n" +
+	"\x04file\x18\x04 \x01(\v2\x14.message.FileContentH\x00R\x04file\x120\n" +
+	"\x06system\x18\x05 \x01(\v2\x16.message.SystemContentH\x00R\x06system\x12+\n" +
+	"\vmention_ids\x18\n" +
+	" \x03(\x04B\n" +
+	"\xfaB\a\x92\x01\x04\x10d\x18\x01R\n" +
+	"mentionIds\x12\x1f\n" +
+	"\vmention_all\x18\v \x01(\bR\n" +
+	"mentionAllB\t\n" +
 	"\acontent\"-\n" +
 	"\vTextContent\x12\x1e\n" +
 	"\x04text\x18\x01 \x01(\tB\n" +
@@ -561,9 +1978,28 @@ const file_pkg_protocol_proto_message_message_ext_proto_rawDesc = "" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
 	"\x04size\x18\x03 \x01(\x04R\x04size\x12\x1b\n" +
-	"\tmime_type\x18\x04 \x01(\tR\bmimeType2v\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\"\xa8\x01\n" +
+	"\rSystemContent\x12-\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x19.message.SystemNoticeTypeR\x04type\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x04R\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"target_ids\x18\x03 \x03(\x04R\ttargetIds\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\x03R\x05until\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text*\xbf\x01\n" +
+	"\x10SystemNoticeType\x12\x19\n" +
+	"\x15SYSTEM_NOTICE_UNKNOWN\x10\x00\x12 \n" +
+	"\x1cSYSTEM_NOTICE_GROUP_MUTE_ALL\x10\x01\x12\"\n" +
+	"\x1eSYSTEM_NOTICE_GROUP_UNMUTE_ALL\x10\x02\x12#\n" +
+	"\x1fSYSTEM_NOTICE_GROUP_MEMBER_MUTE\x10\x03\x12%\n" +
+	"!SYSTEM_NOTICE_GROUP_MEMBER_UNMUTE\x10\x042\x9a\x06\n" +
 	"\x11MessageExtService\x12a\n" +
-	"\vSendMessage\x12\x1b.message.SendMessageRequest\x1a\x19.message.SendMessageReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/messageB\x1bZ\x19pkg/protocol/pb/messagepbb\x06proto3"
+	"\vSendMessage\x12\x1b.message.SendMessageRequest\x1a\x19.message.SendMessageReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/message\x12\xa2\x01\n" +
+	"\x17GetConversationMessages\x12'.message.GetConversationMessagesRequest\x1a%.message.GetConversationMessagesReply\"7\x82\xd3\xe4\x93\x021\x12//api/v1/conversation/{conversation_id}/messages\x12z\n" +
+	"\x11ListConversations\x12!.message.ListConversationsRequest\x1a\x1f.message.ListConversationsReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/conversation/list\x12\x98\x01\n" +
+	"\x14MarkConversationRead\x12$.message.MarkConversationReadRequest\x1a\".message.MarkConversationReadReply\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/conversation/{conversation_id}/read\x12{\n" +
+	"\rRecallMessage\x12\x1d.message.RecallMessageRequest\x1a\x1b.message.RecallMessageReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/message/{message_id}/recall\x12i\n" +
+	"\fSyncMessages\x12\x1c.message.SyncMessagesRequest\x1a\x1a.message.SyncMessagesReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/message/syncB%Z#im-server/pkg/protocol/pb/messagepbb\x06proto3"
 
 var (
 	file_pkg_protocol_proto_message_message_ext_proto_rawDescOnce sync.Once
@@ -577,29 +2013,69 @@ func file_pkg_protocol_proto_message_message_ext_proto_rawDescGZIP() []byte {
 	return file_pkg_protocol_proto_message_message_ext_proto_rawDescData
 }
 
-var file_pkg_protocol_proto_message_message_ext_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pkg_protocol_proto_message_message_ext_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_protocol_proto_message_message_ext_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_pkg_protocol_proto_message_message_ext_proto_goTypes = []any{
-	(*SendMessageRequest)(nil), // 0: message.SendMessageRequest
-	(*SendMessageReply)(nil),   // 1: message.SendMessageReply
-	(*MessageContent)(nil),     // 2: message.MessageContent
-	(*TextContent)(nil),        // 3: message.TextContent
-	(*ImageContent)(nil),       // 4: message.ImageContent
-	(*AudioContent)(nil),       // 5: message.AudioContent
-	(*FileContent)(nil),        // 6: message.FileContent
+	(SystemNoticeType)(0),                  // 0: message.SystemNoticeType
+	(*SendMessageRequest)(nil),             // 1: message.SendMessageRequest
+	(*SendMessageReply)(nil),               // 2: message.SendMessageReply
+	(*GetConversationMessagesRequest)(nil), // 3: message.GetConversationMessagesRequest
+	(*GetConversationMessagesReply)(nil),   // 4: message.GetConversationMessagesReply
+	(*MessageInfo)(nil),                    // 5: message.MessageInfo
+	(*QuotedMessage)(nil),                  // 6: message.QuotedMessage
+	(*ListConversationsRequest)(nil),       // 7: message.ListConversationsRequest
+	(*ListConversationsReply)(nil),         // 8: message.ListConversationsReply
+	(*ConversationInfo)(nil),               // 9: message.ConversationInfo
+	(*PeerInfo)(nil),                       // 10: message.PeerInfo
+	(*MarkConversationReadRequest)(nil),    // 11: message.MarkConversationReadRequest
+	(*MarkConversationReadReply)(nil),      // 12: message.MarkConversationReadReply
+	(*RecallMessageRequest)(nil),           // 13: message.RecallMessageRequest
+	(*RecallMessageReply)(nil),             // 14: message.RecallMessageReply
+	(*SyncMessagesRequest)(nil),            // 15: message.SyncMessagesRequest
+	(*SyncMessagesReply)(nil),              // 16: message.SyncMessagesReply
+	(*SyncCursor)(nil),                     // 17: message.SyncCursor
+	(*GroupBrief)(nil),                     // 18: message.GroupBrief
+	(*MessageContent)(nil),                 // 19: message.MessageContent
+	(*TextContent)(nil),                    // 20: message.TextContent
+	(*ImageContent)(nil),                   // 21: message.ImageContent
+	(*AudioContent)(nil),                   // 22: message.AudioContent
+	(*FileContent)(nil),                    // 23: message.FileContent
+	(*SystemContent)(nil),                  // 24: message.SystemContent
 }
 var file_pkg_protocol_proto_message_message_ext_proto_depIdxs = []int32{
-	2, // 0: message.SendMessageRequest.content:type_name -> message.MessageContent
-	3, // 1: message.MessageContent.text:type_name -> message.TextContent
-	4, // 2: message.MessageContent.image:type_name -> message.ImageContent
-	5, // 3: message.MessageContent.audio:type_name -> message.AudioContent
-	6, // 4: message.MessageContent.file:type_name -> message.FileContent
-	0, // 5: message.MessageExtService.SendMessage:input_type -> message.SendMessageRequest
-	1, // 6: message.MessageExtService.SendMessage:output_type -> message.SendMessageReply
-	6, // [6:7] is the sub-list for method output_type
-	5, // [5:6] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	19, // 0: message.SendMessageRequest.content:type_name -> message.MessageContent
+	5,  // 1: message.GetConversationMessagesReply.messages:type_name -> message.MessageInfo
+	19, // 2: message.MessageInfo.content:type_name -> message.MessageContent
+	6,  // 3: message.MessageInfo.reply_to:type_name -> message.QuotedMessage
+	9,  // 4: message.ListConversationsReply.conversations:type_name -> message.ConversationInfo
+	10, // 5: message.ConversationInfo.peer:type_name -> message.PeerInfo
+	18, // 6: message.ConversationInfo.group:type_name -> message.GroupBrief
+	17, // 7: message.SyncMessagesRequest.cursors:type_name -> message.SyncCursor
+	5,  // 8: message.SyncMessagesReply.messages:type_name -> message.MessageInfo
+	17, // 9: message.SyncMessagesReply.cursors:type_name -> message.SyncCursor
+	20, // 10: message.MessageContent.text:type_name -> message.TextContent
+	21, // 11: message.MessageContent.image:type_name -> message.ImageContent
+	22, // 12: message.MessageContent.audio:type_name -> message.AudioContent
+	23, // 13: message.MessageContent.file:type_name -> message.FileContent
+	24, // 14: message.MessageContent.system:type_name -> message.SystemContent
+	0,  // 15: message.SystemContent.type:type_name -> message.SystemNoticeType
+	1,  // 16: message.MessageExtService.SendMessage:input_type -> message.SendMessageRequest
+	3,  // 17: message.MessageExtService.GetConversationMessages:input_type -> message.GetConversationMessagesRequest
+	7,  // 18: message.MessageExtService.ListConversations:input_type -> message.ListConversationsRequest
+	11, // 19: message.MessageExtService.MarkConversationRead:input_type -> message.MarkConversationReadRequest
+	13, // 20: message.MessageExtService.RecallMessage:input_type -> message.RecallMessageRequest
+	15, // 21: message.MessageExtService.SyncMessages:input_type -> message.SyncMessagesRequest
+	2,  // 22: message.MessageExtService.SendMessage:output_type -> message.SendMessageReply
+	4,  // 23: message.MessageExtService.GetConversationMessages:output_type -> message.GetConversationMessagesReply
+	8,  // 24: message.MessageExtService.ListConversations:output_type -> message.ListConversationsReply
+	12, // 25: message.MessageExtService.MarkConversationRead:output_type -> message.MarkConversationReadReply
+	14, // 26: message.MessageExtService.RecallMessage:output_type -> message.RecallMessageReply
+	16, // 27: message.MessageExtService.SyncMessages:output_type -> message.SyncMessagesReply
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_pkg_protocol_proto_message_message_ext_proto_init() }
@@ -607,24 +2083,26 @@ func file_pkg_protocol_proto_message_message_ext_proto_init() {
 	if File_pkg_protocol_proto_message_message_ext_proto != nil {
 		return
 	}
-	file_pkg_protocol_proto_message_message_ext_proto_msgTypes[2].OneofWrappers = []any{
+	file_pkg_protocol_proto_message_message_ext_proto_msgTypes[18].OneofWrappers = []any{
 		(*MessageContent_Text)(nil),
 		(*MessageContent_Image)(nil),
 		(*MessageContent_Audio)(nil),
 		(*MessageContent_File)(nil),
+		(*MessageContent_System)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_proto_message_message_ext_proto_rawDesc), len(file_pkg_protocol_proto_message_message_ext_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_protocol_proto_message_message_ext_proto_goTypes,
 		DependencyIndexes: file_pkg_protocol_proto_message_message_ext_proto_depIdxs,
+		EnumInfos:         file_pkg_protocol_proto_message_message_ext_proto_enumTypes,
 		MessageInfos:      file_pkg_protocol_proto_message_message_ext_proto_msgTypes,
 	}.Build()
 	File_pkg_protocol_proto_message_message_ext_proto = out.File
@@ -57,10 +57,2207 @@ func (m *SendMessageRequest) validate(all bool) error {
 
 	var errors []error
 
-	if m.GetRecipientId() <= 0 {
+	// no validation rules for RecipientId
+
+	if utf8.RuneCountInString(m.GetClientMsgId()) < 1 {
+		err := SendMessageRequestValidationError{
+			field:  "ClientMsgId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendMessageRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendMessageRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendMessageRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GroupId
+
+	if utf8.RuneCountInString(m.GetReplyToMsgId()) > 32 {
 		err := SendMessageRequestValidationError{
-			field:  "RecipientId",
-			reason: "value must be greater than 0",
+			field:  "ReplyToMsgId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SendMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by SendMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SendMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendMessageRequestMultiError) AllErrors() []error { return m }
+
+// SendMessageRequestValidationError is the validation error returned by
+// SendMessageRequest.Validate if the designated constraints aren't met.
+type SendMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendMessageRequestValidationError) ErrorName() string {
+	return "SendMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendMessageRequestValidationError{}
+
+// Validate checks the field values on SendMessageReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SendMessageReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendMessageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendMessageReplyMultiError, or nil if none found.
+func (m *SendMessageReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendMessageReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for Seq
+
+	// no validation rules for ServerTime
+
+	// no validation rules for ClientMsgId
+
+	if len(errors) > 0 {
+		return SendMessageReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendMessageReplyMultiError is an error wrapping multiple validation errors
+// returned by SendMessageReply.ValidateAll() if the designated constraints
+// aren't met.
+type SendMessageReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendMessageReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendMessageReplyMultiError) AllErrors() []error { return m }
+
+// SendMessageReplyValidationError is the validation error returned by
+// SendMessageReply.Validate if the designated constraints aren't met.
+type SendMessageReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendMessageReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendMessageReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendMessageReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendMessageReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendMessageReplyValidationError) ErrorName() string { return "SendMessageReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendMessageReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendMessageReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendMessageReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendMessageReplyValidationError{}
+
+// Validate checks the field values on GetConversationMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConversationMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConversationMessagesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetConversationMessagesRequestMultiError, or nil if none found.
+func (m *GetConversationMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConversationMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetConversationId()); l < 1 || l > 32 {
+		err := GetConversationMessagesRequestValidationError{
+			field:  "ConversationId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBeforeSeq() < 0 {
+		err := GetConversationMessagesRequestValidationError{
+			field:  "BeforeSeq",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAfterSeq() < 0 {
+		err := GetConversationMessagesRequestValidationError{
+			field:  "AfterSeq",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() > 100 {
+		err := GetConversationMessagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetConversationMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConversationMessagesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetConversationMessagesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetConversationMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConversationMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConversationMessagesRequestMultiError) AllErrors() []error { return m }
+
+// GetConversationMessagesRequestValidationError is the validation error
+// returned by GetConversationMessagesRequest.Validate if the designated
+// constraints aren't met.
+type GetConversationMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConversationMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConversationMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConversationMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConversationMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConversationMessagesRequestValidationError) ErrorName() string {
+	return "GetConversationMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConversationMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConversationMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConversationMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConversationMessagesRequestValidationError{}
+
+// Validate checks the field values on GetConversationMessagesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConversationMessagesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConversationMessagesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConversationMessagesReplyMultiError, or nil if none found.
+func (m *GetConversationMessagesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConversationMessagesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConversationMessagesReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConversationMessagesReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConversationMessagesReplyValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HasMore
+
+	if len(errors) > 0 {
+		return GetConversationMessagesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConversationMessagesReplyMultiError is an error wrapping multiple
+// validation errors returned by GetConversationMessagesReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetConversationMessagesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConversationMessagesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConversationMessagesReplyMultiError) AllErrors() []error { return m }
+
+// GetConversationMessagesReplyValidationError is the validation error returned
+// by GetConversationMessagesReply.Validate if the designated constraints
+// aren't met.
+type GetConversationMessagesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConversationMessagesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConversationMessagesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConversationMessagesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConversationMessagesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConversationMessagesReplyValidationError) ErrorName() string {
+	return "GetConversationMessagesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConversationMessagesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConversationMessagesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConversationMessagesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConversationMessagesReplyValidationError{}
+
+// Validate checks the field values on MessageInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageInfoMultiError, or
+// nil if none found.
+func (m *MessageInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for SenderId
+
+	// no validation rules for RecipientId
+
+	// no validation rules for Seq
+
+	// no validation rules for MessageType
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageInfoValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageInfoValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageInfoValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetReplyTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageInfoValidationError{
+					field:  "ReplyTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageInfoValidationError{
+					field:  "ReplyTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplyTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageInfoValidationError{
+				field:  "ReplyTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MessageInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageInfoMultiError is an error wrapping multiple validation errors
+// returned by MessageInfo.ValidateAll() if the designated constraints aren't met.
+type MessageInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageInfoMultiError) AllErrors() []error { return m }
+
+// MessageInfoValidationError is the validation error returned by
+// MessageInfo.Validate if the designated constraints aren't met.
+type MessageInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageInfoValidationError) ErrorName() string { return "MessageInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageInfoValidationError{}
+
+// Validate checks the field values on QuotedMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotedMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotedMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotedMessageMultiError, or
+// nil if none found.
+func (m *QuotedMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotedMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	// no validation rules for SenderId
+
+	// no validation rules for MessageType
+
+	// no validation rules for Preview
+
+	if len(errors) > 0 {
+		return QuotedMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotedMessageMultiError is an error wrapping multiple validation errors
+// returned by QuotedMessage.ValidateAll() if the designated constraints
+// aren't met.
+type QuotedMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotedMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotedMessageMultiError) AllErrors() []error { return m }
+
+// QuotedMessageValidationError is the validation error returned by
+// QuotedMessage.Validate if the designated constraints aren't met.
+type QuotedMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotedMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotedMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotedMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotedMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotedMessageValidationError) ErrorName() string { return "QuotedMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotedMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotedMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotedMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotedMessageValidationError{}
+
+// Validate checks the field values on ListConversationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConversationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConversationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConversationsRequestMultiError, or nil if none found.
+func (m *ListConversationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConversationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCursorUpdatedAt() < 0 {
+		err := ListConversationsRequestValidationError{
+			field:  "CursorUpdatedAt",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursorConversationId()) > 32 {
+		err := ListConversationsRequestValidationError{
+			field:  "CursorConversationId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() > 100 {
+		err := ListConversationsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListConversationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConversationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListConversationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListConversationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConversationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConversationsRequestMultiError) AllErrors() []error { return m }
+
+// ListConversationsRequestValidationError is the validation error returned by
+// ListConversationsRequest.Validate if the designated constraints aren't met.
+type ListConversationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConversationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConversationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConversationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConversationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConversationsRequestValidationError) ErrorName() string {
+	return "ListConversationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConversationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConversationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConversationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConversationsRequestValidationError{}
+
+// Validate checks the field values on ListConversationsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConversationsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConversationsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConversationsReplyMultiError, or nil if none found.
+func (m *ListConversationsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConversationsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConversations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListConversationsReplyValidationError{
+						field:  fmt.Sprintf("Conversations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListConversationsReplyValidationError{
+						field:  fmt.Sprintf("Conversations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListConversationsReplyValidationError{
+					field:  fmt.Sprintf("Conversations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HasMore
+
+	// no validation rules for NextCursorUpdatedAt
+
+	// no validation rules for NextCursorConversationId
+
+	// no validation rules for PinnedTruncated
+
+	if len(errors) > 0 {
+		return ListConversationsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConversationsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListConversationsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListConversationsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConversationsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConversationsReplyMultiError) AllErrors() []error { return m }
+
+// ListConversationsReplyValidationError is the validation error returned by
+// ListConversationsReply.Validate if the designated constraints aren't met.
+type ListConversationsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConversationsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConversationsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConversationsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConversationsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConversationsReplyValidationError) ErrorName() string {
+	return "ListConversationsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConversationsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConversationsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConversationsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConversationsReplyValidationError{}
+
+// Validate checks the field values on ConversationInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConversationInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConversationInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConversationInfoMultiError, or nil if none found.
+func (m *ConversationInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConversationInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConversationId
+
+	// no validation rules for Type
+
+	// no validation rules for LastMessageId
+
+	// no validation rules for LastSeq
+
+	// no validation rules for LastMessagePreview
+
+	// no validation rules for UnreadCount
+
+	// no validation rules for LastReadSeq
+
+	// no validation rules for IsPinned
+
+	// no validation rules for IsMuted
+
+	// no validation rules for UpdatedAt
+
+	if all {
+		switch v := interface{}(m.GetPeer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationInfoValidationError{
+					field:  "Peer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationInfoValidationError{
+					field:  "Peer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationInfoValidationError{
+				field:  "Peer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationInfoValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationInfoValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationInfoValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MentionCount
+
+	// no validation rules for FirstUnreadMentionSeq
+
+	if len(errors) > 0 {
+		return ConversationInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationInfoMultiError is an error wrapping multiple validation errors
+// returned by ConversationInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ConversationInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationInfoMultiError) AllErrors() []error { return m }
+
+// ConversationInfoValidationError is the validation error returned by
+// ConversationInfo.Validate if the designated constraints aren't met.
+type ConversationInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationInfoValidationError) ErrorName() string { return "ConversationInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConversationInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversationInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationInfoValidationError{}
+
+// Validate checks the field values on PeerInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PeerInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeerInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PeerInfoMultiError, or nil
+// if none found.
+func (m *PeerInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeerInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for Nickname
+
+	// no validation rules for AvatarUrl
+
+	if len(errors) > 0 {
+		return PeerInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeerInfoMultiError is an error wrapping multiple validation errors returned
+// by PeerInfo.ValidateAll() if the designated constraints aren't met.
+type PeerInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeerInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeerInfoMultiError) AllErrors() []error { return m }
+
+// PeerInfoValidationError is the validation error returned by
+// PeerInfo.Validate if the designated constraints aren't met.
+type PeerInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerInfoValidationError) ErrorName() string { return "PeerInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeerInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeerInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerInfoValidationError{}
+
+// Validate checks the field values on MarkConversationReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkConversationReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkConversationReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkConversationReadRequestMultiError, or nil if none found.
+func (m *MarkConversationReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkConversationReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetConversationId()); l < 1 || l > 32 {
+		err := MarkConversationReadRequestValidationError{
+			field:  "ConversationId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReadSeq() < 0 {
+		err := MarkConversationReadRequestValidationError{
+			field:  "ReadSeq",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MarkConversationReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkConversationReadRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkConversationReadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MarkConversationReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkConversationReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkConversationReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkConversationReadRequestValidationError is the validation error returned
+// by MarkConversationReadRequest.Validate if the designated constraints
+// aren't met.
+type MarkConversationReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkConversationReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkConversationReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkConversationReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkConversationReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkConversationReadRequestValidationError) ErrorName() string {
+	return "MarkConversationReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkConversationReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkConversationReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkConversationReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkConversationReadRequestValidationError{}
+
+// Validate checks the field values on MarkConversationReadReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkConversationReadReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkConversationReadReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkConversationReadReplyMultiError, or nil if none found.
+func (m *MarkConversationReadReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkConversationReadReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConversationId
+
+	// no validation rules for ReadSeq
+
+	if len(errors) > 0 {
+		return MarkConversationReadReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkConversationReadReplyMultiError is an error wrapping multiple validation
+// errors returned by MarkConversationReadReply.ValidateAll() if the
+// designated constraints aren't met.
+type MarkConversationReadReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkConversationReadReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkConversationReadReplyMultiError) AllErrors() []error { return m }
+
+// MarkConversationReadReplyValidationError is the validation error returned by
+// MarkConversationReadReply.Validate if the designated constraints aren't met.
+type MarkConversationReadReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkConversationReadReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkConversationReadReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkConversationReadReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkConversationReadReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkConversationReadReplyValidationError) ErrorName() string {
+	return "MarkConversationReadReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkConversationReadReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkConversationReadReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkConversationReadReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkConversationReadReplyValidationError{}
+
+// Validate checks the field values on RecallMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecallMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecallMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecallMessageRequestMultiError, or nil if none found.
+func (m *RecallMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecallMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetMessageId()); l < 1 || l > 32 {
+		err := RecallMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RecallMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecallMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by RecallMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecallMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecallMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecallMessageRequestMultiError) AllErrors() []error { return m }
+
+// RecallMessageRequestValidationError is the validation error returned by
+// RecallMessageRequest.Validate if the designated constraints aren't met.
+type RecallMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecallMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecallMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecallMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecallMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecallMessageRequestValidationError) ErrorName() string {
+	return "RecallMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecallMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecallMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecallMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecallMessageRequestValidationError{}
+
+// Validate checks the field values on RecallMessageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecallMessageReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecallMessageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecallMessageReplyMultiError, or nil if none found.
+func (m *RecallMessageReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecallMessageReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for Seq
+
+	if len(errors) > 0 {
+		return RecallMessageReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecallMessageReplyMultiError is an error wrapping multiple validation errors
+// returned by RecallMessageReply.ValidateAll() if the designated constraints
+// aren't met.
+type RecallMessageReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecallMessageReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecallMessageReplyMultiError) AllErrors() []error { return m }
+
+// RecallMessageReplyValidationError is the validation error returned by
+// RecallMessageReply.Validate if the designated constraints aren't met.
+type RecallMessageReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecallMessageReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecallMessageReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecallMessageReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecallMessageReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecallMessageReplyValidationError) ErrorName() string {
+	return "RecallMessageReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecallMessageReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecallMessageReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecallMessageReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecallMessageReplyValidationError{}
+
+// Validate checks the field values on SyncMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncMessagesRequestMultiError, or nil if none found.
+func (m *SyncMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetCursors()) > 500 {
+		err := SyncMessagesRequestValidationError{
+			field:  "Cursors",
+			reason: "value must contain no more than 500 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCursors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncMessagesRequestValidationError{
+						field:  fmt.Sprintf("Cursors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncMessagesRequestValidationError{
+						field:  fmt.Sprintf("Cursors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncMessagesRequestValidationError{
+					field:  fmt.Sprintf("Cursors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetLimit() > 100 {
+		err := SyncMessagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetInboxCursor() < 0 {
+		err := SyncMessagesRequestValidationError{
+			field:  "InboxCursor",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SyncMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by SyncMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SyncMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncMessagesRequestMultiError) AllErrors() []error { return m }
+
+// SyncMessagesRequestValidationError is the validation error returned by
+// SyncMessagesRequest.Validate if the designated constraints aren't met.
+type SyncMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncMessagesRequestValidationError) ErrorName() string {
+	return "SyncMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncMessagesRequestValidationError{}
+
+// Validate checks the field values on SyncMessagesReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SyncMessagesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncMessagesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncMessagesReplyMultiError, or nil if none found.
+func (m *SyncMessagesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncMessagesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncMessagesReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncMessagesReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncMessagesReplyValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HasMore
+
+	for idx, item := range m.GetCursors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncMessagesReplyValidationError{
+						field:  fmt.Sprintf("Cursors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncMessagesReplyValidationError{
+						field:  fmt.Sprintf("Cursors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncMessagesReplyValidationError{
+					field:  fmt.Sprintf("Cursors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for InboxCursor
+
+	if len(errors) > 0 {
+		return SyncMessagesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncMessagesReplyMultiError is an error wrapping multiple validation errors
+// returned by SyncMessagesReply.ValidateAll() if the designated constraints
+// aren't met.
+type SyncMessagesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncMessagesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncMessagesReplyMultiError) AllErrors() []error { return m }
+
+// SyncMessagesReplyValidationError is the validation error returned by
+// SyncMessagesReply.Validate if the designated constraints aren't met.
+type SyncMessagesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncMessagesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncMessagesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncMessagesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncMessagesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncMessagesReplyValidationError) ErrorName() string {
+	return "SyncMessagesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncMessagesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncMessagesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncMessagesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncMessagesReplyValidationError{}
+
+// Validate checks the field values on SyncCursor with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SyncCursor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncCursor with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SyncCursorMultiError, or
+// nil if none found.
+func (m *SyncCursor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncCursor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetConversationId()); l < 1 || l > 32 {
+		err := SyncCursorValidationError{
+			field:  "ConversationId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -68,10 +2265,10 @@ func (m *SendMessageRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetClientMsgId()) < 1 {
-		err := SendMessageRequestValidationError{
-			field:  "ClientMsgId",
-			reason: "value length must be at least 1 runes",
+	if m.GetSeq() < 0 {
+		err := SyncCursorValidationError{
+			field:  "Seq",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -79,49 +2276,19 @@ func (m *SendMessageRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetContent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SendMessageRequestValidationError{
-					field:  "Content",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SendMessageRequestValidationError{
-					field:  "Content",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SendMessageRequestValidationError{
-				field:  "Content",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return SendMessageRequestMultiError(errors)
+		return SyncCursorMultiError(errors)
 	}
 
 	return nil
 }
 
-// SendMessageRequestMultiError is an error wrapping multiple validation errors
-// returned by SendMessageRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SendMessageRequestMultiError []error
+// SyncCursorMultiError is an error wrapping multiple validation errors
+// returned by SyncCursor.ValidateAll() if the designated constraints aren't met.
+type SyncCursorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SendMessageRequestMultiError) Error() string {
+func (m SyncCursorMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -130,11 +2297,11 @@ func (m SendMessageRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SendMessageRequestMultiError) AllErrors() []error { return m }
+func (m SyncCursorMultiError) AllErrors() []error { return m }
 
-// SendMessageRequestValidationError is the validation error returned by
-// SendMessageRequest.Validate if the designated constraints aren't met.
-type SendMessageRequestValidationError struct {
+// SyncCursorValidationError is the validation error returned by
+// SyncCursor.Validate if the designated constraints aren't met.
+type SyncCursorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -142,24 +2309,22 @@ type SendMessageRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendMessageRequestValidationError) Field() string { return e.field }
+func (e SyncCursorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendMessageRequestValidationError) Reason() string { return e.reason }
+func (e SyncCursorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendMessageRequestValidationError) Cause() error { return e.cause }
+func (e SyncCursorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendMessageRequestValidationError) Key() bool { return e.key }
+func (e SyncCursorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendMessageRequestValidationError) ErrorName() string {
-	return "SendMessageRequestValidationError"
-}
+func (e SyncCursorValidationError) ErrorName() string { return "SyncCursorValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SendMessageRequestValidationError) Error() string {
+func (e SyncCursorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -171,14 +2336,14 @@ func (e SendMessageRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendMessageRequest.%s: %s%s",
+		"invalid %sSyncCursor.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendMessageRequestValidationError{}
+var _ error = SyncCursorValidationError{}
 
 var _ interface {
 	Field() string
@@ -186,54 +2351,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendMessageRequestValidationError{}
+} = SyncCursorValidationError{}
 
-// Validate checks the field values on SendMessageReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SendMessageReply) Validate() error {
+// Validate checks the field values on GroupBrief with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GroupBrief) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SendMessageReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SendMessageReplyMultiError, or nil if none found.
-func (m *SendMessageReply) ValidateAll() error {
+// ValidateAll checks the field values on GroupBrief with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GroupBriefMultiError, or
+// nil if none found.
+func (m *GroupBrief) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SendMessageReply) validate(all bool) error {
+func (m *GroupBrief) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for MessageId
-
-	// no validation rules for ConversationId
+	// no validation rules for GroupId
 
-	// no validation rules for Seq
+	// no validation rules for Name
 
-	// no validation rules for ServerTime
+	// no validation rules for AvatarUrl
 
-	// no validation rules for ClientMsgId
+	// no validation rules for UserNum
 
 	if len(errors) > 0 {
-		return SendMessageReplyMultiError(errors)
+		return GroupBriefMultiError(errors)
 	}
 
 	return nil
 }
 
-// SendMessageReplyMultiError is an error wrapping multiple validation errors
-// returned by SendMessageReply.ValidateAll() if the designated constraints
-// aren't met.
-type SendMessageReplyMultiError []error
+// GroupBriefMultiError is an error wrapping multiple validation errors
+// returned by GroupBrief.ValidateAll() if the designated constraints aren't met.
+type GroupBriefMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SendMessageReplyMultiError) Error() string {
+func (m GroupBriefMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -242,11 +2404,11 @@ func (m SendMessageReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SendMessageReplyMultiError) AllErrors() []error { return m }
+func (m GroupBriefMultiError) AllErrors() []error { return m }
 
-// SendMessageReplyValidationError is the validation error returned by
-// SendMessageReply.Validate if the designated constraints aren't met.
-type SendMessageReplyValidationError struct {
+// GroupBriefValidationError is the validation error returned by
+// GroupBrief.Validate if the designated constraints aren't met.
+type GroupBriefValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -254,22 +2416,22 @@ type SendMessageReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendMessageReplyValidationError) Field() string { return e.field }
+func (e GroupBriefValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendMessageReplyValidationError) Reason() string { return e.reason }
+func (e GroupBriefValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendMessageReplyValidationError) Cause() error { return e.cause }
+func (e GroupBriefValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendMessageReplyValidationError) Key() bool { return e.key }
+func (e GroupBriefValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendMessageReplyValidationError) ErrorName() string { return "SendMessageReplyValidationError" }
+func (e GroupBriefValidationError) ErrorName() string { return "GroupBriefValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SendMessageReplyValidationError) Error() string {
+func (e GroupBriefValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -281,14 +2443,14 @@ func (e SendMessageReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendMessageReply.%s: %s%s",
+		"invalid %sGroupBrief.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendMessageReplyValidationError{}
+var _ error = GroupBriefValidationError{}
 
 var _ interface {
 	Field() string
@@ -296,7 +2458,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendMessageReplyValidationError{}
+} = GroupBriefValidationError{}
 
 // Validate checks the field values on MessageContent with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -320,6 +2482,40 @@ func (m *MessageContent) validate(all bool) error {
 
 	var errors []error
 
+	if len(m.GetMentionIds()) > 100 {
+		err := MessageContentValidationError{
+			field:  "MentionIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_MessageContent_MentionIds_Unique := make(map[uint64]struct{}, len(m.GetMentionIds()))
+
+	for idx, item := range m.GetMentionIds() {
+		_, _ = idx, item
+
+		if _, exists := _MessageContent_MentionIds_Unique[item]; exists {
+			err := MessageContentValidationError{
+				field:  fmt.Sprintf("MentionIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_MessageContent_MentionIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for MentionIds[idx]
+	}
+
+	// no validation rules for MentionAll
+
 	switch v := m.Content.(type) {
 	case *MessageContent_Text:
 		if v == nil {
@@ -485,6 +2681,47 @@ func (m *MessageContent) validate(all bool) error {
 			}
 		}
 
+	case *MessageContent_System:
+		if v == nil {
+			err := MessageContentValidationError{
+				field:  "Content",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSystem()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageContentValidationError{
+						field:  "System",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageContentValidationError{
+						field:  "System",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSystem()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageContentValidationError{
+					field:  "System",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -995,3 +3232,111 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = FileContentValidationError{}
+
+// Validate checks the field values on SystemContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SystemContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SystemContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SystemContentMultiError, or
+// nil if none found.
+func (m *SystemContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SystemContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for OperatorId
+
+	// no validation rules for Until
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return SystemContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// SystemContentMultiError is an error wrapping multiple validation errors
+// returned by SystemContent.ValidateAll() if the designated constraints
+// aren't met.
+type SystemContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SystemContentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SystemContentMultiError) AllErrors() []error { return m }
+
+// SystemContentValidationError is the validation error returned by
+// SystemContent.Validate if the designated constraints aren't met.
+type SystemContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SystemContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SystemContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SystemContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SystemContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SystemContentValidationError) ErrorName() string { return "SystemContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SystemContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSystemContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SystemContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SystemContentValidationError{}
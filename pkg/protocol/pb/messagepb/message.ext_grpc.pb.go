@@ -19,15 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MessageExtService_SendMessage_FullMethodName = "/message.MessageExtService/SendMessage"
+	MessageExtService_SendMessage_FullMethodName             = "/message.MessageExtService/SendMessage"
+	MessageExtService_GetConversationMessages_FullMethodName = "/message.MessageExtService/GetConversationMessages"
+	MessageExtService_ListConversations_FullMethodName       = "/message.MessageExtService/ListConversations"
+	MessageExtService_MarkConversationRead_FullMethodName    = "/message.MessageExtService/MarkConversationRead"
+	MessageExtService_RecallMessage_FullMethodName           = "/message.MessageExtService/RecallMessage"
+	MessageExtService_SyncMessages_FullMethodName            = "/message.MessageExtService/SyncMessages"
 )
 
 // MessageExtServiceClient is the client API for MessageExtService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MessageExtServiceClient interface {
-	// 发送好友消息
+	// 发送消息（单聊/群聊）
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageReply, error)
+	// 拉取会话历史消息（按 seq 游标分页）
+	GetConversationMessages(ctx context.Context, in *GetConversationMessagesRequest, opts ...grpc.CallOption) (*GetConversationMessagesReply, error)
+	// 获取会话列表（置顶优先，再按最后活跃时间倒序）
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsReply, error)
+	// 标记会话已读（推进 last_read_seq 并清零未读）
+	MarkConversationRead(ctx context.Context, in *MarkConversationReadRequest, opts ...grpc.CallOption) (*MarkConversationReadReply, error)
+	// 撤回消息（仅发送者，且在撤回时间窗口内）
+	RecallMessage(ctx context.Context, in *RecallMessageRequest, opts ...grpc.CallOption) (*RecallMessageReply, error)
+	// 离线同步（按会话 seq 游标批量拉取缺失的消息）
+	SyncMessages(ctx context.Context, in *SyncMessagesRequest, opts ...grpc.CallOption) (*SyncMessagesReply, error)
 }
 
 type messageExtServiceClient struct {
@@ -48,12 +63,72 @@ func (c *messageExtServiceClient) SendMessage(ctx context.Context, in *SendMessa
 	return out, nil
 }
 
+func (c *messageExtServiceClient) GetConversationMessages(ctx context.Context, in *GetConversationMessagesRequest, opts ...grpc.CallOption) (*GetConversationMessagesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationMessagesReply)
+	err := c.cc.Invoke(ctx, MessageExtService_GetConversationMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageExtServiceClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationsReply)
+	err := c.cc.Invoke(ctx, MessageExtService_ListConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageExtServiceClient) MarkConversationRead(ctx context.Context, in *MarkConversationReadRequest, opts ...grpc.CallOption) (*MarkConversationReadReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkConversationReadReply)
+	err := c.cc.Invoke(ctx, MessageExtService_MarkConversationRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageExtServiceClient) RecallMessage(ctx context.Context, in *RecallMessageRequest, opts ...grpc.CallOption) (*RecallMessageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecallMessageReply)
+	err := c.cc.Invoke(ctx, MessageExtService_RecallMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageExtServiceClient) SyncMessages(ctx context.Context, in *SyncMessagesRequest, opts ...grpc.CallOption) (*SyncMessagesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncMessagesReply)
+	err := c.cc.Invoke(ctx, MessageExtService_SyncMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageExtServiceServer is the server API for MessageExtService service.
 // All implementations must embed UnimplementedMessageExtServiceServer
 // for forward compatibility.
 type MessageExtServiceServer interface {
-	// 发送好友消息
+	// 发送消息（单聊/群聊）
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageReply, error)
+	// 拉取会话历史消息（按 seq 游标分页）
+	GetConversationMessages(context.Context, *GetConversationMessagesRequest) (*GetConversationMessagesReply, error)
+	// 获取会话列表（置顶优先，再按最后活跃时间倒序）
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsReply, error)
+	// 标记会话已读（推进 last_read_seq 并清零未读）
+	MarkConversationRead(context.Context, *MarkConversationReadRequest) (*MarkConversationReadReply, error)
+	// 撤回消息（仅发送者，且在撤回时间窗口内）
+	RecallMessage(context.Context, *RecallMessageRequest) (*RecallMessageReply, error)
+	// 离线同步（按会话 seq 游标批量拉取缺失的消息）
+	SyncMessages(context.Context, *SyncMessagesRequest) (*SyncMessagesReply, error)
 	mustEmbedUnimplementedMessageExtServiceServer()
 }
 
@@ -67,6 +142,21 @@ type UnimplementedMessageExtServiceServer struct{}
 func (UnimplementedMessageExtServiceServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (UnimplementedMessageExtServiceServer) GetConversationMessages(context.Context, *GetConversationMessagesRequest) (*GetConversationMessagesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationMessages not implemented")
+}
+func (UnimplementedMessageExtServiceServer) ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversations not implemented")
+}
+func (UnimplementedMessageExtServiceServer) MarkConversationRead(context.Context, *MarkConversationReadRequest) (*MarkConversationReadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkConversationRead not implemented")
+}
+func (UnimplementedMessageExtServiceServer) RecallMessage(context.Context, *RecallMessageRequest) (*RecallMessageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecallMessage not implemented")
+}
+func (UnimplementedMessageExtServiceServer) SyncMessages(context.Context, *SyncMessagesRequest) (*SyncMessagesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncMessages not implemented")
+}
 func (UnimplementedMessageExtServiceServer) mustEmbedUnimplementedMessageExtServiceServer() {}
 func (UnimplementedMessageExtServiceServer) testEmbeddedByValue()                           {}
 
@@ -106,6 +196,96 @@ func _MessageExtService_SendMessage_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageExtService_GetConversationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageExtServiceServer).GetConversationMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageExtService_GetConversationMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageExtServiceServer).GetConversationMessages(ctx, req.(*GetConversationMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageExtService_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageExtServiceServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageExtService_ListConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageExtServiceServer).ListConversations(ctx, req.(*ListConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageExtService_MarkConversationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkConversationReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageExtServiceServer).MarkConversationRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageExtService_MarkConversationRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageExtServiceServer).MarkConversationRead(ctx, req.(*MarkConversationReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageExtService_RecallMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecallMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageExtServiceServer).RecallMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageExtService_RecallMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageExtServiceServer).RecallMessage(ctx, req.(*RecallMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageExtService_SyncMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageExtServiceServer).SyncMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageExtService_SyncMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageExtServiceServer).SyncMessages(ctx, req.(*SyncMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageExtService_ServiceDesc is the grpc.ServiceDesc for MessageExtService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,6 +297,26 @@ var MessageExtService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessage",
 			Handler:    _MessageExtService_SendMessage_Handler,
 		},
+		{
+			MethodName: "GetConversationMessages",
+			Handler:    _MessageExtService_GetConversationMessages_Handler,
+		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _MessageExtService_ListConversations_Handler,
+		},
+		{
+			MethodName: "MarkConversationRead",
+			Handler:    _MessageExtService_MarkConversationRead_Handler,
+		},
+		{
+			MethodName: "RecallMessage",
+			Handler:    _MessageExtService_RecallMessage_Handler,
+		},
+		{
+			MethodName: "SyncMessages",
+			Handler:    _MessageExtService_SyncMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/protocol/proto/message/message.ext.proto",
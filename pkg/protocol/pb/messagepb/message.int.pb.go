@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v3.21.12
+// source: pkg/protocol/proto/message/message.int.proto
+
+package messagepb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SendGroupSystemMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群组id
+	Content       *SystemContent         `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                 // 系统消息内容（operator_id 作为发送者）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendGroupSystemMessageRequest) Reset() {
+	*x = SendGroupSystemMessageRequest{}
+	mi := &file_pkg_protocol_proto_message_message_int_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendGroupSystemMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendGroupSystemMessageRequest) ProtoMessage() {}
+
+func (x *SendGroupSystemMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_int_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendGroupSystemMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendGroupSystemMessageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_int_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SendGroupSystemMessageRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SendGroupSystemMessageRequest) GetContent() *SystemContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ResolveSignalTargetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 发送者id
+	RecipientId   uint64                 `protobuf:"varint,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"` // 单聊接收方
+	GroupId       uint64                 `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`             // 群聊群组id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveSignalTargetsRequest) Reset() {
+	*x = ResolveSignalTargetsRequest{}
+	mi := &file_pkg_protocol_proto_message_message_int_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveSignalTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSignalTargetsRequest) ProtoMessage() {}
+
+func (x *ResolveSignalTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_int_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSignalTargetsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveSignalTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_int_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResolveSignalTargetsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ResolveSignalTargetsRequest) GetRecipientId() uint64 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *ResolveSignalTargetsRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type ResolveSignalTargetsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipients    []uint64               `protobuf:"varint,1,rep,packed,name=recipients,proto3" json:"recipients,omitempty"` // 接收方用户id，不含发送者
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveSignalTargetsReply) Reset() {
+	*x = ResolveSignalTargetsReply{}
+	mi := &file_pkg_protocol_proto_message_message_int_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveSignalTargetsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSignalTargetsReply) ProtoMessage() {}
+
+func (x *ResolveSignalTargetsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_proto_message_message_int_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSignalTargetsReply.ProtoReflect.Descriptor instead.
+func (*ResolveSignalTargetsReply) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_proto_message_message_int_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResolveSignalTargetsReply) GetRecipients() []uint64 {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+var File_pkg_protocol_proto_message_message_int_proto protoreflect.FileDescriptor
+
+const file_pkg_protocol_proto_message_message_int_proto_rawDesc = "" +
+	"\n" +
+	",pkg/protocol/proto/message/message.int.proto\x12\amessage\x1a,pkg/protocol/proto/message/message.ext.proto\x1a\x17validate/validate.proto\"\x7f\n" +
+	"\x1dSendGroupSystemMessageRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02(\x01R\agroupId\x12:\n" +
+	"\acontent\x18\x02 \x01(\v2\x16.message.SystemContentB\b\xfaB\x05\x8a\x01\x02\x10\x01R\acontent\"}\n" +
+	"\x1bResolveSignalTargetsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02(\x01R\x06userId\x12!\n" +
+	"\frecipient_id\x18\x02 \x01(\x04R\vrecipientId\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\x04R\agroupId\";\n" +
+	"\x19ResolveSignalTargetsReply\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x01 \x03(\x04R\n" +
+	"recipients2\xd2\x01\n" +
+	"\x11MessageIntService\x12[\n" +
+	"\x16SendGroupSystemMessage\x12&.message.SendGroupSystemMessageRequest\x1a\x19.message.SendMessageReply\x12`\n" +
+	"\x14ResolveSignalTargets\x12$.message.ResolveSignalTargetsRequest\x1a\".message.ResolveSignalTargetsReplyB%Z#im-server/pkg/protocol/pb/messagepbb\x06proto3"
+
+var (
+	file_pkg_protocol_proto_message_message_int_proto_rawDescOnce sync.Once
+	file_pkg_protocol_proto_message_message_int_proto_rawDescData []byte
+)
+
+func file_pkg_protocol_proto_message_message_int_proto_rawDescGZIP() []byte {
+	file_pkg_protocol_proto_message_message_int_proto_rawDescOnce.Do(func() {
+		file_pkg_protocol_proto_message_message_int_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_protocol_proto_message_message_int_proto_rawDesc), len(file_pkg_protocol_proto_message_message_int_proto_rawDesc)))
+	})
+	return file_pkg_protocol_proto_message_message_int_proto_rawDescData
+}
+
+var file_pkg_protocol_proto_message_message_int_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_protocol_proto_message_message_int_proto_goTypes = []any{
+	(*SendGroupSystemMessageRequest)(nil), // 0: message.SendGroupSystemMessageRequest
+	(*ResolveSignalTargetsRequest)(nil),   // 1: message.ResolveSignalTargetsRequest
+	(*ResolveSignalTargetsReply)(nil),     // 2: message.ResolveSignalTargetsReply
+	(*SystemContent)(nil),                 // 3: message.SystemContent
+	(*SendMessageReply)(nil),              // 4: message.SendMessageReply
+}
+var file_pkg_protocol_proto_message_message_int_proto_depIdxs = []int32{
+	3, // 0: message.SendGroupSystemMessageRequest.content:type_name -> message.SystemContent
+	0, // 1: message.MessageIntService.SendGroupSystemMessage:input_type -> message.SendGroupSystemMessageRequest
+	1, // 2: message.MessageIntService.ResolveSignalTargets:input_type -> message.ResolveSignalTargetsRequest
+	4, // 3: message.MessageIntService.SendGroupSystemMessage:output_type -> message.SendMessageReply
+	2, // 4: message.MessageIntService.ResolveSignalTargets:output_type -> message.ResolveSignalTargetsReply
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_protocol_proto_message_message_int_proto_init() }
+func file_pkg_protocol_proto_message_message_int_proto_init() {
+	if File_pkg_protocol_proto_message_message_int_proto != nil {
+		return
+	}
+	file_pkg_protocol_proto_message_message_ext_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_proto_message_message_int_proto_rawDesc), len(file_pkg_protocol_proto_message_message_int_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_protocol_proto_message_message_int_proto_goTypes,
+		DependencyIndexes: file_pkg_protocol_proto_message_message_int_proto_depIdxs,
+		MessageInfos:      file_pkg_protocol_proto_message_message_int_proto_msgTypes,
+	}.Build()
+	File_pkg_protocol_proto_message_message_int_proto = out.File
+	file_pkg_protocol_proto_message_message_int_proto_goTypes = nil
+	file_pkg_protocol_proto_message_message_int_proto_depIdxs = nil
+}
@@ -0,0 +1,410 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/protocol/proto/message/message.int.proto
+
+package messagepb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SendGroupSystemMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendGroupSystemMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendGroupSystemMessageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendGroupSystemMessageRequestMultiError, or nil if none found.
+func (m *SendGroupSystemMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendGroupSystemMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() < 1 {
+		err := SendGroupSystemMessageRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetContent() == nil {
+		err := SendGroupSystemMessageRequestValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendGroupSystemMessageRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendGroupSystemMessageRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendGroupSystemMessageRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendGroupSystemMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendGroupSystemMessageRequestMultiError is an error wrapping multiple
+// validation errors returned by SendGroupSystemMessageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SendGroupSystemMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendGroupSystemMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendGroupSystemMessageRequestMultiError) AllErrors() []error { return m }
+
+// SendGroupSystemMessageRequestValidationError is the validation error
+// returned by SendGroupSystemMessageRequest.Validate if the designated
+// constraints aren't met.
+type SendGroupSystemMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendGroupSystemMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendGroupSystemMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendGroupSystemMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendGroupSystemMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendGroupSystemMessageRequestValidationError) ErrorName() string {
+	return "SendGroupSystemMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendGroupSystemMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendGroupSystemMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendGroupSystemMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendGroupSystemMessageRequestValidationError{}
+
+// Validate checks the field values on ResolveSignalTargetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveSignalTargetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveSignalTargetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveSignalTargetsRequestMultiError, or nil if none found.
+func (m *ResolveSignalTargetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveSignalTargetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := ResolveSignalTargetsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RecipientId
+
+	// no validation rules for GroupId
+
+	if len(errors) > 0 {
+		return ResolveSignalTargetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveSignalTargetsRequestMultiError is an error wrapping multiple
+// validation errors returned by ResolveSignalTargetsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ResolveSignalTargetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveSignalTargetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveSignalTargetsRequestMultiError) AllErrors() []error { return m }
+
+// ResolveSignalTargetsRequestValidationError is the validation error returned
+// by ResolveSignalTargetsRequest.Validate if the designated constraints
+// aren't met.
+type ResolveSignalTargetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveSignalTargetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveSignalTargetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveSignalTargetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveSignalTargetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveSignalTargetsRequestValidationError) ErrorName() string {
+	return "ResolveSignalTargetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveSignalTargetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveSignalTargetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveSignalTargetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveSignalTargetsRequestValidationError{}
+
+// Validate checks the field values on ResolveSignalTargetsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveSignalTargetsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveSignalTargetsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveSignalTargetsReplyMultiError, or nil if none found.
+func (m *ResolveSignalTargetsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveSignalTargetsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResolveSignalTargetsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveSignalTargetsReplyMultiError is an error wrapping multiple validation
+// errors returned by ResolveSignalTargetsReply.ValidateAll() if the
+// designated constraints aren't met.
+type ResolveSignalTargetsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveSignalTargetsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveSignalTargetsReplyMultiError) AllErrors() []error { return m }
+
+// ResolveSignalTargetsReplyValidationError is the validation error returned by
+// ResolveSignalTargetsReply.Validate if the designated constraints aren't met.
+type ResolveSignalTargetsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveSignalTargetsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveSignalTargetsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveSignalTargetsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveSignalTargetsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveSignalTargetsReplyValidationError) ErrorName() string {
+	return "ResolveSignalTargetsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveSignalTargetsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveSignalTargetsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveSignalTargetsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveSignalTargetsReplyValidationError{}
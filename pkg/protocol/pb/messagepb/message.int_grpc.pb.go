@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: pkg/protocol/proto/message/message.int.proto
+
+package messagepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MessageIntService_SendGroupSystemMessage_FullMethodName = "/message.MessageIntService/SendGroupSystemMessage"
+	MessageIntService_ResolveSignalTargets_FullMethodName   = "/message.MessageIntService/ResolveSignalTargets"
+)
+
+// MessageIntServiceClient is the client API for MessageIntService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 消息内部服务，仅供服务间调用
+type MessageIntServiceClient interface {
+	// 向群聊会话发送系统消息（如禁言变更通知）
+	SendGroupSystemMessage(ctx context.Context, in *SendGroupSystemMessageRequest, opts ...grpc.CallOption) (*SendMessageReply, error)
+	// 校验瞬时信号的发送权限并返回接收方：单聊须为好友，群聊须为群成员
+	ResolveSignalTargets(ctx context.Context, in *ResolveSignalTargetsRequest, opts ...grpc.CallOption) (*ResolveSignalTargetsReply, error)
+}
+
+type messageIntServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMessageIntServiceClient(cc grpc.ClientConnInterface) MessageIntServiceClient {
+	return &messageIntServiceClient{cc}
+}
+
+func (c *messageIntServiceClient) SendGroupSystemMessage(ctx context.Context, in *SendGroupSystemMessageRequest, opts ...grpc.CallOption) (*SendMessageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendMessageReply)
+	err := c.cc.Invoke(ctx, MessageIntService_SendGroupSystemMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageIntServiceClient) ResolveSignalTargets(ctx context.Context, in *ResolveSignalTargetsRequest, opts ...grpc.CallOption) (*ResolveSignalTargetsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveSignalTargetsReply)
+	err := c.cc.Invoke(ctx, MessageIntService_ResolveSignalTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MessageIntServiceServer is the server API for MessageIntService service.
+// All implementations must embed UnimplementedMessageIntServiceServer
+// for forward compatibility.
+//
+// 消息内部服务，仅供服务间调用
+type MessageIntServiceServer interface {
+	// 向群聊会话发送系统消息（如禁言变更通知）
+	SendGroupSystemMessage(context.Context, *SendGroupSystemMessageRequest) (*SendMessageReply, error)
+	// 校验瞬时信号的发送权限并返回接收方：单聊须为好友，群聊须为群成员
+	ResolveSignalTargets(context.Context, *ResolveSignalTargetsRequest) (*ResolveSignalTargetsReply, error)
+	mustEmbedUnimplementedMessageIntServiceServer()
+}
+
+// UnimplementedMessageIntServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMessageIntServiceServer struct{}
+
+func (UnimplementedMessageIntServiceServer) SendGroupSystemMessage(context.Context, *SendGroupSystemMessageRequest) (*SendMessageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGroupSystemMessage not implemented")
+}
+func (UnimplementedMessageIntServiceServer) ResolveSignalTargets(context.Context, *ResolveSignalTargetsRequest) (*ResolveSignalTargetsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSignalTargets not implemented")
+}
+func (UnimplementedMessageIntServiceServer) mustEmbedUnimplementedMessageIntServiceServer() {}
+func (UnimplementedMessageIntServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeMessageIntServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MessageIntServiceServer will
+// result in compilation errors.
+type UnsafeMessageIntServiceServer interface {
+	mustEmbedUnimplementedMessageIntServiceServer()
+}
+
+func RegisterMessageIntServiceServer(s grpc.ServiceRegistrar, srv MessageIntServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMessageIntServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MessageIntService_ServiceDesc, srv)
+}
+
+func _MessageIntService_SendGroupSystemMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendGroupSystemMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageIntServiceServer).SendGroupSystemMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageIntService_SendGroupSystemMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageIntServiceServer).SendGroupSystemMessage(ctx, req.(*SendGroupSystemMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageIntService_ResolveSignalTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSignalTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageIntServiceServer).ResolveSignalTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageIntService_ResolveSignalTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageIntServiceServer).ResolveSignalTargets(ctx, req.(*ResolveSignalTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MessageIntService_ServiceDesc is the grpc.ServiceDesc for MessageIntService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MessageIntService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "message.MessageIntService",
+	HandlerType: (*MessageIntServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendGroupSystemMessage",
+			Handler:    _MessageIntService_SendGroupSystemMessage_Handler,
+		},
+		{
+			MethodName: "ResolveSignalTargets",
+			Handler:    _MessageIntService_ResolveSignalTargets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/protocol/proto/message/message.int.proto",
+}
@@ -217,7 +217,7 @@ const file_pkg_protocol_proto_user_user_ext_proto_rawDesc = "" +
 	"avatar_url\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\tavatarUrl2q\n" +
 	"\x0eUserExtService\x12_\n" +
 	"\n" +
-	"SearchUser\x12\x17.user.SearchUserRequest\x1a\x18.user.SearchUserResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/user/searchB\x18Z\x16pkg/protocol/pb/userpbb\x06proto3"
+	"SearchUser\x12\x17.user.SearchUserRequest\x1a\x18.user.SearchUserResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/user/searchB\"Z im-server/pkg/protocol/pb/userpbb\x06proto3"
 
 var (
 	file_pkg_protocol_proto_user_user_ext_proto_rawDescOnce sync.Once
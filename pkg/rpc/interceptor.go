@@ -26,7 +26,8 @@ func ValidationUnaryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
-// JWTAuthUnaryInterceptor JWT 认证拦截器，验证 token 并注入 user_id 到 context
+// JWTAuthUnaryInterceptor JWT 认证拦截器，验证 token 并注入 user_id 到 context。
+// 服务器上注册的所有方法都需要认证，内部服务应注册在单独的内网监听上
 func JWTAuthUnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		md, ok := metadata.FromIncomingContext(ctx)
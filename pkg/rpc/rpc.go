@@ -1,15 +1,27 @@
 package rpc
 
 import (
+	"context"
+	"sync"
+
 	"im-server/pkg/config"
+	"im-server/pkg/protocol/pb/connectpb"
 	"im-server/pkg/protocol/pb/devicepb"
+	"im-server/pkg/protocol/pb/messagepb"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 var (
-	deviceIntClient devicepb.DeviceIntServiceClient
+	deviceIntClient  devicepb.DeviceIntServiceClient
+	messageExtClient messagepb.MessageExtServiceClient
+	messageIntClient messagepb.MessageIntServiceClient
+
+	// connect 节点按地址区分，每个节点一个客户端
+	connectIntMu      sync.Mutex
+	connectIntClients = map[string]connectpb.ConnectIntServiceClient{}
 )
 
 func SetDeviceIntServiceClient(client devicepb.DeviceIntServiceClient) {
@@ -24,6 +36,53 @@ func GetDeviceIntServiceClient() devicepb.DeviceIntServiceClient {
 	return deviceIntClient
 }
 
+func SetMessageExtServiceClient(client messagepb.MessageExtServiceClient) {
+	messageExtClient = client
+}
+
+func GetMessageExtServiceClient() messagepb.MessageExtServiceClient {
+	if messageExtClient == nil {
+		conn := newGrpcClient(config.Config.GRPCClient.MessageTargetAddr)
+		messageExtClient = messagepb.NewMessageExtServiceClient(conn)
+	}
+	return messageExtClient
+}
+
+func SetMessageIntServiceClient(client messagepb.MessageIntServiceClient) {
+	messageIntClient = client
+}
+
+func GetMessageIntServiceClient() messagepb.MessageIntServiceClient {
+	if messageIntClient == nil {
+		conn := newGrpcClient(config.Config.GRPCClient.MessageIntTargetAddr)
+		messageIntClient = messagepb.NewMessageIntServiceClient(conn)
+	}
+	return messageIntClient
+}
+
+func SetConnectIntServiceClient(addr string, client connectpb.ConnectIntServiceClient) {
+	connectIntMu.Lock()
+	defer connectIntMu.Unlock()
+	connectIntClients[addr] = client
+}
+
+// GetConnectIntServiceClient 获取指定 connect 节点的客户端，addr 为设备登录时记录的 conn_addr
+func GetConnectIntServiceClient(addr string) connectpb.ConnectIntServiceClient {
+	connectIntMu.Lock()
+	defer connectIntMu.Unlock()
+	client, ok := connectIntClients[addr]
+	if !ok {
+		client = connectpb.NewConnectIntServiceClient(newGrpcClient(addr))
+		connectIntClients[addr] = client
+	}
+	return client
+}
+
+// WithToken 将用户 token 以 authorization 元数据附加到出站 context，供下游 JWTAuthUnaryInterceptor 校验
+func WithToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
 func newGrpcClient(address string) *grpc.ClientConn {
 	conn, err := grpc.NewClient(address,
 		grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -18,6 +18,7 @@ type MessageBody struct {
 	RecipientID    uint64             `bson:"recipient_id"`
 	Type           int32              `bson:"type"`
 	Body           []byte             `bson:"body"`
+	Recalled       bool               `bson:"recalled,omitempty"`
 	CreatedAt      time.Time          `bson:"created_at"`
 }
 
@@ -43,3 +44,33 @@ func (c *Client) SaveMessageBody(ctx context.Context, mb *MessageBody) error {
 	_, err := c.Messages.InsertOne(ctx, mb)
 	return err
 }
+
+// GetMessageBodies 按 message_id 批量读取消息体，返回 message_id -> 消息体
+func (c *Client) GetMessageBodies(ctx context.Context, messageIDs []string) (map[string]*MessageBody, error) {
+	bodies := make(map[string]*MessageBody, len(messageIDs))
+	if len(messageIDs) == 0 {
+		return bodies, nil
+	}
+	cur, err := c.Messages.Find(ctx, bson.M{"message_id": bson.M{"$in": messageIDs}})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var mb MessageBody
+		if err := cur.Decode(&mb); err != nil {
+			return nil, err
+		}
+		bodies[mb.MessageID] = &mb
+	}
+	return bodies, cur.Err()
+}
+
+// MarkRecalled 将消息体标记为已撤回（保留原始内容用于审计，读取方不再下发）
+func (c *Client) MarkRecalled(ctx context.Context, messageID string) error {
+	_, err := c.Messages.UpdateOne(ctx,
+		bson.M{"message_id": messageID},
+		bson.M{"$set": bson.M{"recalled": true, "recalled_at": time.Now()}},
+	)
+	return err
+}